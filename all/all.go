@@ -12,6 +12,12 @@ import (
 	_ "3e8.eu/go/dsl/lancom"
 	_ "3e8.eu/go/dsl/lantiq"
 	_ "3e8.eu/go/dsl/mediatek"
+	_ "3e8.eu/go/dsl/openwrt"
 	_ "3e8.eu/go/dsl/sagemcom"
+	_ "3e8.eu/go/dsl/snmpmib"
 	_ "3e8.eu/go/dsl/speedport"
+	_ "3e8.eu/go/dsl/technicolor"
+	_ "3e8.eu/go/dsl/tr064"
+	_ "3e8.eu/go/dsl/tr181"
+	_ "3e8.eu/go/dsl/zyxel"
 )
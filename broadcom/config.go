@@ -9,17 +9,30 @@ import (
 )
 
 type TelnetConfig struct {
-	Host     string
-	User     string
-	Password dsl.PasswordCallback
-	Command  string
+	Host           string
+	User           string
+	Password       dsl.PasswordCallback
+	Command        string
+	Serial         bool
+	BaudRate       int
+	AccountPrompt  string
+	PasswordPrompt string
+	CommandPrompt  string
+	PreCommands    []string
 }
 
 type SSHConfig struct {
-	Host        string
-	User        string
-	Password    dsl.PasswordCallback
-	PrivateKeys dsl.PrivateKeysCallback
-	KnownHosts  string
-	Command     string
+	Host           string
+	User           string
+	Password       dsl.PasswordCallback
+	PrivateKeys    dsl.PrivateKeysCallback
+	SSHAgent       bool
+	KnownHosts     string
+	UnknownHostKey dsl.UnknownHostKeyCallback
+	JumpHost       *dsl.JumpHostConfig
+	Command        string
+}
+
+type LocalConfig struct {
+	Command string
 }
@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package broadcom
+
+import (
+	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/exec"
+	"3e8.eu/go/dsl/internal/xdslctl"
+	"3e8.eu/go/dsl/models"
+)
+
+type localClient struct {
+	command    string
+	executor   exec.LocalExecutor
+	rawData    []byte
+	status     models.Status
+	bins       models.Bins
+	lines      []models.Line
+	secondLine bool
+	commands   [2]xdslctl.Commands
+}
+
+func NewLocalClient(config LocalConfig) (dsl.Client, error) {
+	c := localClient{}
+	c.command = config.Command
+	c.secondLine = xdslctl.HasSecondLine(&c.executor, c.command)
+
+	return &c, nil
+}
+
+func (c *localClient) RawData() []byte {
+	return c.rawData
+}
+
+func (c *localClient) Status() models.Status {
+	return c.status
+}
+
+func (c *localClient) Bins() models.Bins {
+	return c.bins
+}
+
+func (c *localClient) Lines() []models.Line {
+	return c.lines
+}
+
+func (c *localClient) UpdateData() (err error) {
+	c.lines, c.rawData, err = xdslctl.UpdateDataLines(&c.executor, c.command, c.secondLine, &c.commands)
+	if err == nil {
+		c.status, c.bins = c.lines[0].Status, c.lines[0].Bins
+	}
+	return
+}
+
+func (c *localClient) Close() {
+}
@@ -6,6 +6,7 @@ package broadcom
 
 import (
 	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/telnet"
 )
 
 func init() {
@@ -16,12 +17,28 @@ func init() {
 		},
 	}
 
+	telnetOptions := map[string]dsl.Option{
+		"Command":        options["Command"],
+		"Serial":         telnet.GetSerialOption(),
+		"BaudRate":       telnet.GetBaudRateOption(),
+		"AccountPrompt":  telnet.GetAccountPromptOption(),
+		"PasswordPrompt": telnet.GetPasswordPromptOption(),
+		"CommandPrompt":  telnet.GetCommandPromptOption(),
+		"PreCommands":    telnet.GetPreCommandsOption(),
+	}
+
 	newTelnet := func(config dsl.Config) (dsl.Client, error) {
 		telnetConfig := TelnetConfig{
-			Host:     config.Host,
-			User:     config.User,
-			Password: config.AuthPassword,
-			Command:  config.Options["Command"],
+			Host:           config.Host,
+			User:           config.User,
+			Password:       config.AuthPassword,
+			Command:        config.Options["Command"],
+			Serial:         config.Options["Serial"] == "1",
+			BaudRate:       telnet.ParseBaudRate(config.Options["BaudRate"]),
+			AccountPrompt:  config.Options["AccountPrompt"],
+			PasswordPrompt: config.Options["PasswordPrompt"],
+			CommandPrompt:  config.Options["CommandPrompt"],
+			PreCommands:    telnet.ParsePreCommands(config.Options["PreCommands"]),
 		}
 		return NewTelnetClient(telnetConfig)
 	}
@@ -29,27 +46,45 @@ func init() {
 		Title:              "Broadcom (Telnet)",
 		RequiresUser:       dsl.TristateMaybe,
 		SupportedAuthTypes: dsl.AuthTypePassword,
-		Options:            options,
+		Options:            telnetOptions,
 	}
 	dsl.RegisterClient("broadcom_telnet", newTelnet, clientDescTelnet)
 
 	newSSH := func(config dsl.Config) (dsl.Client, error) {
 		sshConfig := SSHConfig{
-			Host:        config.Host,
-			User:        config.User,
-			Password:    config.AuthPassword,
-			PrivateKeys: config.AuthPrivateKeys,
-			KnownHosts:  config.KnownHosts,
-			Command:     config.Options["Command"],
+			Host:           config.Host,
+			User:           config.User,
+			Password:       config.AuthPassword,
+			PrivateKeys:    config.AuthPrivateKeys,
+			SSHAgent:       config.AuthSSHAgent,
+			KnownHosts:     config.KnownHosts,
+			UnknownHostKey: config.UnknownHostKey,
+			JumpHost:       config.JumpHost,
+			Command:        config.Options["Command"],
 		}
 		return NewSSHClient(sshConfig)
 	}
 	clientDescSSH := dsl.ClientDesc{
 		Title:              "Broadcom (SSH)",
 		RequiresUser:       dsl.TristateYes,
-		SupportedAuthTypes: dsl.AuthTypePassword | dsl.AuthTypePrivateKeys,
+		SupportedAuthTypes: dsl.AuthTypePassword | dsl.AuthTypePrivateKeys | dsl.AuthTypeSSHAgent,
 		RequiresKnownHosts: true,
+		SupportsJumpHost:   true,
 		Options:            options,
 	}
 	dsl.RegisterClient("broadcom_ssh", newSSH, clientDescSSH)
+
+	newLocal := func(config dsl.Config) (dsl.Client, error) {
+		localConfig := LocalConfig{
+			Command: config.Options["Command"],
+		}
+		return NewLocalClient(localConfig)
+	}
+	clientDescLocal := dsl.ClientDesc{
+		Title:        "Broadcom (Local)",
+		RequiresUser: dsl.TristateNo,
+		IsLocal:      true,
+		Options:      options,
+	}
+	dsl.RegisterClient("broadcom_local", newLocal, clientDescLocal)
 }
@@ -7,15 +7,19 @@ package broadcom
 import (
 	"3e8.eu/go/dsl"
 	"3e8.eu/go/dsl/internal/ssh"
+	"3e8.eu/go/dsl/internal/xdslctl"
 	"3e8.eu/go/dsl/models"
 )
 
 type sshClient struct {
-	command string
-	client  *ssh.Client
-	rawData []byte
-	status  models.Status
-	bins    models.Bins
+	command    string
+	client     *ssh.Client
+	rawData    []byte
+	status     models.Status
+	bins       models.Bins
+	lines      []models.Line
+	secondLine bool
+	commands   [2]xdslctl.Commands
 }
 
 func NewSSHClient(config SSHConfig) (dsl.Client, error) {
@@ -24,11 +28,13 @@ func NewSSHClient(config SSHConfig) (dsl.Client, error) {
 
 	var err error
 
-	c.client, err = ssh.NewClient(config.Host, config.User, config.Password, config.PrivateKeys, config.KnownHosts)
+	c.client, err = ssh.NewClient(config.Host, config.User, config.Password, config.PrivateKeys, config.SSHAgent, config.KnownHosts, config.UnknownHostKey, config.JumpHost)
 	if err != nil {
 		return nil, err
 	}
 
+	c.secondLine = xdslctl.HasSecondLine(c.client, c.command)
+
 	return &c, nil
 }
 
@@ -44,8 +50,15 @@ func (c *sshClient) Bins() models.Bins {
 	return c.bins
 }
 
+func (c *sshClient) Lines() []models.Line {
+	return c.lines
+}
+
 func (c *sshClient) UpdateData() (err error) {
-	c.status, c.bins, c.rawData, err = updateData(c.client, c.command)
+	c.lines, c.rawData, err = xdslctl.UpdateDataLines(c.client, c.command, c.secondLine, &c.commands)
+	if err == nil {
+		c.status, c.bins = c.lines[0].Status, c.lines[0].Bins
+	}
 	return
 }
 
@@ -7,15 +7,19 @@ package broadcom
 import (
 	"3e8.eu/go/dsl"
 	"3e8.eu/go/dsl/internal/telnet"
+	"3e8.eu/go/dsl/internal/xdslctl"
 	"3e8.eu/go/dsl/models"
 )
 
 type telnetClient struct {
-	command string
-	client  *telnet.Client
-	rawData []byte
-	status  models.Status
-	bins    models.Bins
+	command    string
+	client     *telnet.Client
+	rawData    []byte
+	status     models.Status
+	bins       models.Bins
+	lines      []models.Line
+	secondLine bool
+	commands   [2]xdslctl.Commands
 }
 
 func NewTelnetClient(config TelnetConfig) (dsl.Client, error) {
@@ -48,12 +52,20 @@ func NewTelnetClient(config TelnetConfig) (dsl.Client, error) {
 				Command:  "> ",
 			},
 		},
+		Serial:      config.Serial,
+		BaudRate:    config.BaudRate,
+		PreCommands: config.PreCommands,
 	}
+	clientConfig.Prompts = telnet.WithCustomPrompts(clientConfig.Prompts,
+		config.AccountPrompt, config.PasswordPrompt, config.CommandPrompt)
+
 	c.client, err = telnet.NewClient(clientConfig, config.Host, user, config.Password)
 	if err != nil {
 		return nil, err
 	}
 
+	c.secondLine = xdslctl.HasSecondLine(c.client, c.command)
+
 	return &c, nil
 }
 
@@ -69,8 +81,15 @@ func (c *telnetClient) Bins() models.Bins {
 	return c.bins
 }
 
+func (c *telnetClient) Lines() []models.Line {
+	return c.lines
+}
+
 func (c *telnetClient) UpdateData() (err error) {
-	c.status, c.bins, c.rawData, err = updateData(c.client, c.command)
+	c.lines, c.rawData, err = xdslctl.UpdateDataLines(c.client, c.command, c.secondLine, &c.commands)
+	if err == nil {
+		c.status, c.bins = c.lines[0].Status, c.lines[0].Bins
+	}
 	return
 }
 
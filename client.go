@@ -45,13 +45,34 @@ type Client interface {
 	Close()
 }
 
+// MultiLineClient is implemented by clients for devices with multiple lines, such as bonded connections.
+// Status and Bins of such a client return the data of the first line.
+type MultiLineClient interface {
+	Client
+	Lines() []models.Line
+}
+
+// GetLines returns the data of all lines of a client. For clients which only support a single line, the
+// result contains the data returned by Status and Bins.
+func GetLines(client Client) []models.Line {
+	if c, ok := client.(MultiLineClient); ok {
+		return c.Lines()
+	}
+	return []models.Line{{Status: client.Status(), Bins: client.Bins()}}
+}
+
 func NewClient(config Config) (Client, error) {
 	newFunc, ok := getClientNewFunc(config.Type)
 	if !ok {
 		return nil, errors.New("invalid client type")
 	}
 
-	return newFunc(config)
+	client, err := newReconnectClient(newFunc, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
 }
 
 func GetClientTypes() []ClientType {
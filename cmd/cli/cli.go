@@ -5,9 +5,12 @@
 package cli
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"syscall"
 	"time"
 
@@ -16,6 +19,7 @@ import (
 	"3e8.eu/go/dsl"
 	"3e8.eu/go/dsl/graphs"
 	"3e8.eu/go/dsl/models"
+	"3e8.eu/go/dsl/probe"
 )
 
 func readPassword(prompt string) string {
@@ -29,6 +33,17 @@ func readPassword(prompt string) string {
 	return string(passwordBytes)
 }
 
+func readConfirmation(prompt string) bool {
+	fmt.Print(prompt)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && answer == "" {
+		panic(err)
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "yes" || answer == "y"
+}
+
 func LoadData(config dsl.Config) {
 	clientDesc := config.Type.ClientDesc()
 
@@ -63,6 +78,37 @@ func LoadData(config dsl.Config) {
 		}
 	}
 
+	if config.UnknownHostKey != nil {
+		addHostKey := config.UnknownHostKey
+		config.UnknownHostKey = func(host, fingerprint, knownHostsLine string) error {
+			fmt.Println(" unknown host key")
+			fmt.Println("Host: " + host)
+			fmt.Println("Fingerprint: " + fingerprint)
+			if !readConfirmation("Trust this host key and add it to the known hosts file? (yes/no): ") {
+				return errors.New("host key not trusted")
+			}
+			fmt.Print("Connecting…")
+			return addHostKey(host, fingerprint, knownHostsLine)
+		}
+	}
+
+	if config.JumpHost != nil {
+		if config.JumpHost.UnknownHostKey != nil {
+			config.JumpHost.UnknownHostKey = config.UnknownHostKey
+		}
+		if config.JumpHost.AuthPassword == nil {
+			config.JumpHost.AuthPassword = func() (string, error) {
+				fmt.Println(" jump host password required")
+				password := readPassword("Jump host password: ")
+				fmt.Print("Authenticating…")
+				return password, nil
+			}
+		}
+		if config.JumpHost.AuthPrivateKeys.Passphrase == nil {
+			config.JumpHost.AuthPrivateKeys.Passphrase = config.AuthPrivateKeys.Passphrase
+		}
+	}
+
 	client, err := dsl.NewClient(config)
 	if err != nil {
 		fmt.Println(" failed:", err)
@@ -82,24 +128,94 @@ func LoadData(config dsl.Config) {
 	fmt.Println(" done")
 	fmt.Println()
 
-	fmt.Println(client.Status().Summary())
+	lines := dsl.GetLines(client)
+	if len(lines) > 1 {
+		fmt.Println(models.NewBondingStatus(lines).Summary())
+	}
+	for i, line := range lines {
+		if len(lines) > 1 {
+			fmt.Printf("Line %d:\n\n", i+1)
+		}
+		fmt.Println(line.Status.Summary())
+	}
 
 	filenameBase := time.Now().Format("dsl_20060102_150405_")
 
-	writeFile(filenameBase+"summary.txt", []byte(client.Status().Summary()))
+	if len(lines) > 1 {
+		writeFile(filenameBase+"bonding.txt", []byte(models.NewBondingStatus(lines).Summary()))
+	}
 	writeFile(filenameBase+"raw.txt", client.RawData())
 
+	for i, line := range lines {
+		lineFilenameBase := filenameBase
+		if i != 0 {
+			lineFilenameBase += fmt.Sprintf("line%d_", i+1)
+		}
+		writeLineFiles(lineFilenameBase, line)
+	}
+}
+
+func writeLineFiles(filenameBase string, line models.Line) {
+	writeFile(filenameBase+"summary.txt", []byte(line.Status.Summary()))
+
 	graphParamsScaled := graphs.DefaultGraphParamsWithLegend
 	graphParamsScaled.PreferDynamicAxisLimits = true
 
-	writeGraph(filenameBase+"bits.svg", client.Bins(), graphs.DrawBitsGraph, graphs.DefaultGraphParamsWithLegend)
-	writeGraph(filenameBase+"bits_scaled.svg", client.Bins(), graphs.DrawBitsGraph, graphParamsScaled)
-	writeGraph(filenameBase+"snr.svg", client.Bins(), graphs.DrawSNRGraph, graphs.DefaultGraphParamsWithLegend)
-	writeGraph(filenameBase+"snr_scaled.svg", client.Bins(), graphs.DrawSNRGraph, graphParamsScaled)
-	writeGraph(filenameBase+"qln.svg", client.Bins(), graphs.DrawQLNGraph, graphs.DefaultGraphParamsWithLegend)
-	writeGraph(filenameBase+"qln_scaled.svg", client.Bins(), graphs.DrawQLNGraph, graphParamsScaled)
-	writeGraph(filenameBase+"hlog.svg", client.Bins(), graphs.DrawHlogGraph, graphs.DefaultGraphParamsWithLegend)
-	writeGraph(filenameBase+"hlog_scaled.svg", client.Bins(), graphs.DrawHlogGraph, graphParamsScaled)
+	writeGraph(filenameBase+"bits.svg", line.Bins, graphs.DrawBitsGraph, graphs.DefaultGraphParamsWithLegend)
+	writeGraph(filenameBase+"bits_scaled.svg", line.Bins, graphs.DrawBitsGraph, graphParamsScaled)
+	writeGraph(filenameBase+"snr.svg", line.Bins, graphs.DrawSNRGraph, graphs.DefaultGraphParamsWithLegend)
+	writeGraph(filenameBase+"snr_scaled.svg", line.Bins, graphs.DrawSNRGraph, graphParamsScaled)
+	writeGraph(filenameBase+"qln.svg", line.Bins, graphs.DrawQLNGraph, graphs.DefaultGraphParamsWithLegend)
+	writeGraph(filenameBase+"qln_scaled.svg", line.Bins, graphs.DrawQLNGraph, graphParamsScaled)
+	writeGraph(filenameBase+"hlog.svg", line.Bins, graphs.DrawHlogGraph, graphs.DefaultGraphParamsWithLegend)
+	writeGraph(filenameBase+"hlog_scaled.svg", line.Bins, graphs.DrawHlogGraph, graphParamsScaled)
+
+	if len(line.Bins.PSD.Downstream.Data) != 0 || len(line.Bins.PSD.Upstream.Data) != 0 {
+		writeGraph(filenameBase+"psd.svg", line.Bins, graphs.DrawPSDGraph, graphs.DefaultGraphParamsWithLegend)
+		writeGraph(filenameBase+"psd_scaled.svg", line.Bins, graphs.DrawPSDGraph, graphParamsScaled)
+	}
+
+	if len(line.Bins.Hlin.Downstream.Real) != 0 || len(line.Bins.Hlin.Upstream.Real) != 0 {
+		writeGraph(filenameBase+"hlin.svg", line.Bins, graphs.DrawHlinGraph, graphs.DefaultGraphParamsWithLegend)
+	}
+
+	if len(line.Bins.Gains.Downstream.Data) != 0 || len(line.Bins.Gains.Upstream.Data) != 0 {
+		writeGraph(filenameBase+"gains.svg", line.Bins, graphs.DrawGainsGraph, graphs.DefaultGraphParamsWithLegend)
+	}
+
+	if len(line.Bins.FEXTCancellation.Downstream.Data) != 0 || len(line.Bins.FEXTCancellation.Upstream.Data) != 0 {
+		writeGraph(filenameBase+"fext.svg", line.Bins, graphs.DrawFEXTCancellationGraph, graphs.DefaultGraphParamsWithLegend)
+	}
+}
+
+func Probe(host string) {
+	fmt.Println()
+	fmt.Print("Probing…")
+
+	results, err := probe.Probe(host)
+	if err != nil {
+		fmt.Println(" failed:", err)
+		os.Exit(1)
+	}
+
+	fmt.Println(" done")
+	fmt.Println()
+
+	if len(results) == 0 {
+		fmt.Println("No supported device detected.")
+		return
+	}
+
+	fmt.Println("Likely device types:")
+	fmt.Println()
+
+	for _, result := range results {
+		fmt.Printf("  %s (%s), score %d\n", result.Type, result.Type.ClientDesc().Title, result.Score)
+		for _, reason := range result.Reasons {
+			fmt.Println("    - " + reason)
+		}
+		fmt.Println()
+	}
 }
 
 func createFile(filename string) *os.File {
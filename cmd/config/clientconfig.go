@@ -5,7 +5,9 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 
@@ -25,6 +27,35 @@ func loadKnownHosts(file string) (string, error) {
 	return string(data), nil
 }
 
+func addKnownHost(file, line string) error {
+	data, err := os.ReadFile(file)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if len(data) != 0 && data[len(data)-1] != '\n' {
+		line = "\n" + line
+	}
+
+	err = os.MkdirAll(filepath.Dir(file), 0700)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.WriteString(line + "\n")
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
 func loadPrivateKeys(file string) ([]string, error) {
 	if file == "" {
 		return nil, nil
@@ -60,16 +91,27 @@ func ClientConfig() (dsl.Config, error) {
 	clientDesc := Config.DeviceType.ClientDesc()
 
 	var knownHosts string
+	var unknownHostKeyCallback dsl.UnknownHostKeyCallback
 	if clientDesc.RequiresKnownHosts {
 		if Config.KnownHostsPath == "IGNORE" {
 			knownHosts = "IGNORE"
 			fmt.Println("WARNING: Host key validation disabled!")
-		} else {
+		} else if Config.KnownHostsPath != "" {
 			var err error
 			knownHosts, err = loadKnownHosts(Config.KnownHostsPath)
 			if err != nil {
 				return dsl.Config{}, fmt.Errorf("failed to load known hosts file: %w", err)
 			}
+
+			// the user interface needs to ask for confirmation before calling this
+			knownHostsPath := Config.KnownHostsPath
+			unknownHostKeyCallback = func(host, fingerprint, knownHostsLine string) error {
+				err := addKnownHost(knownHostsPath, knownHostsLine)
+				if err != nil {
+					return fmt.Errorf("failed to update known hosts file: %w", err)
+				}
+				return nil
+			}
 		}
 	}
 
@@ -96,6 +138,8 @@ func ClientConfig() (dsl.Config, error) {
 		}
 	}
 
+	sshAgent := clientDesc.SupportedAuthTypes&dsl.AuthTypeSSHAgent != 0 && Config.UseSSHAgent
+
 	var encryptionPassphraseCallback dsl.EncryptionPassphraseCallback
 	if clientDesc.SupportsEncryptionPassphrase {
 		if Secrets.EncryptionPassphrase != "" {
@@ -103,14 +147,32 @@ func ClientConfig() (dsl.Config, error) {
 		}
 	}
 
+	var jumpHost *dsl.JumpHostConfig
+	if clientDesc.SupportsJumpHost && Config.JumpHost != "" {
+		jumpHost = &dsl.JumpHostConfig{
+			Host:            Config.JumpHost,
+			User:            Config.JumpHostUser,
+			AuthPrivateKeys: privateKeysCallback,
+			AuthSSHAgent:    sshAgent,
+			KnownHosts:      knownHosts,
+			UnknownHostKey:  unknownHostKeyCallback,
+		}
+		if Secrets.JumpHostPassword != "" {
+			jumpHost.AuthPassword = dsl.Password(Secrets.JumpHostPassword)
+		}
+	}
+
 	clientConfig := dsl.Config{
 		Type:                 Config.DeviceType,
 		Host:                 Config.Host,
 		User:                 Config.User,
 		AuthPassword:         passwordCallback,
 		AuthPrivateKeys:      privateKeysCallback,
+		AuthSSHAgent:         sshAgent,
 		EncryptionPassphrase: encryptionPassphraseCallback,
 		KnownHosts:           knownHosts,
+		UnknownHostKey:       unknownHostKeyCallback,
+		JumpHost:             jumpHost,
 		Options:              Config.Options,
 	}
 
@@ -34,7 +34,10 @@ type ConfigData struct {
 	Host           string
 	User           string
 	PrivateKeyPath string
+	UseSSHAgent    bool
 	KnownHostsPath string
+	JumpHost       string
+	JumpHostUser   string
 	Options        map[string]string
 	Web            web.Config
 }
@@ -97,6 +100,13 @@ func Save() error {
 		}
 	}
 
+	if Config.UseSSHAgent {
+		err = enc.Encode(map[string]bool{"UseSSHAgent": Config.UseSSHAgent})
+		if err != nil {
+			return err
+		}
+	}
+
 	if Config.KnownHostsPath != DefaultKnownHostsPath {
 		err = enc.Encode(map[string]string{"KnownHostsPath": Config.KnownHostsPath})
 		if err != nil {
@@ -104,6 +114,20 @@ func Save() error {
 		}
 	}
 
+	if Config.JumpHost != "" {
+		err = enc.Encode(map[string]string{"JumpHost": Config.JumpHost})
+		if err != nil {
+			return err
+		}
+	}
+
+	if Config.JumpHostUser != "" {
+		err = enc.Encode(map[string]string{"JumpHostUser": Config.JumpHostUser})
+		if err != nil {
+			return err
+		}
+	}
+
 	err = enc.Encode(map[string]map[string]string{"Options": Config.Options})
 	if err != nil {
 		return err
@@ -123,7 +147,7 @@ func Validate() error {
 	}
 	clientDesc := Config.DeviceType.ClientDesc()
 
-	if Config.Host == "" {
+	if Config.Host == "" && !clientDesc.IsLocal {
 		return errors.New("no hostname specified")
 	}
 
@@ -133,6 +157,12 @@ func Validate() error {
 		return errors.New("no username specified")
 	}
 
+	if Config.JumpHost != "" && !clientDesc.SupportsJumpHost {
+		return errors.New("jump host specified, but not supported for device")
+	} else if Config.JumpHost != "" && Config.JumpHostUser == "" {
+		return errors.New("no username specified for jump host")
+	}
+
 	for optionKey := range Config.Options {
 		valid := false
 		for option := range clientDesc.Options {
@@ -14,6 +14,7 @@ var (
 
 type SecretsData struct {
 	Password             string
+	JumpHostPassword     string
 	PrivateKeyPassphrase string
 	EncryptionPassphrase string
 }
@@ -286,6 +286,7 @@ func save() {
 		lastMessage.State != string(common.StatePasswordRequired) &&
 		lastMessage.State != string(common.StatePassphraseRequired) &&
 		lastMessage.State != string(common.StateEncryptionPassphraseRequired) &&
+		lastMessage.State != string(common.StateHostKeyConfirmationRequired) &&
 		lastMessage.State != string(common.StateError) &&
 		lastMessage.State != string(common.StateLoading) {
 
@@ -353,6 +354,20 @@ func setEncryptionPassphrase(data string) {
 	}
 }
 
+func confirmHostKey(data string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if lastMessage.State != string(common.StateHostKeyConfirmationRequired) {
+		return
+	}
+
+	err := c.ConfirmHostKey(data == "trust")
+	if err != nil {
+		fmt.Println("confirming host key failed:", err)
+	}
+}
+
 func connect(cfg json.RawMessage, remember bool) {
 	mutex.Lock()
 	defer mutex.Unlock()
@@ -413,6 +428,7 @@ func disconnect() {
 		lastMessage.State != string(common.StatePasswordRequired) &&
 		lastMessage.State != string(common.StatePassphraseRequired) &&
 		lastMessage.State != string(common.StateEncryptionPassphraseRequired) &&
+		lastMessage.State != string(common.StateHostKeyConfirmationRequired) &&
 		lastMessage.State != string(common.StateError) &&
 		lastMessage.State != string(common.StateLoading) {
 
@@ -448,6 +464,7 @@ func startWebView() {
 	w.Bind("goSetPassword", setPassword)
 	w.Bind("goSetPassphrase", setPassphrase)
 	w.Bind("goSetEncryptionPassphrase", setEncryptionPassphrase)
+	w.Bind("goConfirmHostKey", confirmHostKey)
 	w.Bind("goConnect", connect)
 	w.Bind("goDisconnect", disconnect)
 
@@ -5,6 +5,7 @@
 	const STATE_PASSWORD = "password";
 	const STATE_PASSPHRASE = "passphrase";
 	const STATE_ENCRYPTION_PASSPHRASE = "encryption-passphrase";
+	const STATE_HOSTKEY = "hostkey";
 	const STATE_ERROR = "error";
 	const STATE_LOADING = "loading";
 	const STATE_INITIALIZING = "initializing";
@@ -17,6 +18,7 @@
 
 	const AuthTypePassword = 1 << 0;
 	const AuthTypePrivateKeys = 1 << 1;
+	const AuthTypeSSHAgent = 1 << 2;
 
 	const OptionTypeString = 0;
 	const OptionTypeBool = 1;
@@ -26,6 +28,8 @@
 	var clientDescs;
 	var bins = null;
 	var binsHistory = null;
+	var lastData = null;
+	var selectedLine = 0;
 
 	var eventSource;
 
@@ -33,17 +37,24 @@
 	var summary, graphs, errors;
 	var checkboxAutoscale, checkboxMinMax;
 	var graphBitsCanvas, graphSNRCanvas, graphQLNCanvas, graphHlogCanvas,
+		graphPSDCanvas, graphFEXTCancellationCanvas, graphFEXTCancellationSection,
+		graphHlinCanvas, graphHlinSection, graphGainsCanvas, graphGainsSection,
 		graphRetransmissionDownCanvas, graphRetransmissionUpCanvas,
 		graphErrorsDownCanvas, graphErrorsUpCanvas,
-		graphErrorSecondsDownCanvas, graphErrorSecondsUpCanvas;
+		graphErrorSecondsDownCanvas, graphErrorSecondsUpCanvas,
+		graphFailureSecondsDownCanvas, graphFailureSecondsUpCanvas,
+		graphFullInitsCanvas, graphRetrainsCanvas;
 	var graphBits, graphSNR, graphQLN, graphHlog,
+		graphPSD, graphFEXTCancellation, graphHlin, graphGains,
 		graphRetransmissionDown, graphRetransmissionUp,
 		graphErrorsDown, graphErrorsUp,
-		graphErrorSecondsDown, graphErrorSecondsUp;
-	var overlay, overlayPassword, overlayPassphrase, overlayEncryptionPassphrase, overlayError, overlayLoading, overlayDisconnecting, overlayConnect;
-	var configAdvanced, configDeviceType, configHost, configUser, configPrivateKey, configKnownHosts, configOptions, configRemember;
+		graphErrorSecondsDown, graphErrorSecondsUp,
+		graphFailureSecondsDown, graphFailureSecondsUp,
+		graphFullInits, graphRetrains;
+	var overlay, overlayPassword, overlayPassphrase, overlayEncryptionPassphrase, overlayHostKey, overlayError, overlayLoading, overlayDisconnecting, overlayConnect;
+	var configAdvanced, configDeviceType, configHost, configUser, configPrivateKey, configSSHAgent, configKnownHosts, configOptions, configRemember;
 	var messages;
-	var fingerprint, inputPassword, inputPassphrase, inputEncryptionPassphrase;
+	var fingerprint, hostKeyHost, hostKeyFingerprint, inputPassword, inputPassphrase, inputEncryptionPassphrase;
 
 	function setConfig(config, clients) {
 		clientDescs = clients;
@@ -62,6 +73,7 @@
 		configHost.value = config.Host;
 		configUser.value = config.User;
 		configPrivateKey.value = config.PrivateKeyPath;
+		configSSHAgent.checked = config.UseSSHAgent;
 		configKnownHosts.value = config.KnownHostsPath;
 
 		for (let option in config.Options) {
@@ -86,10 +98,16 @@
 				"RequiresUser": TRISTATE_MAYBE,
 				"SupportedAuthTypes": 0,
 				"RequiresKnownHosts": false,
+				"IsLocal": false,
 				"Options": null
 			};
 		}
 
+		if (clientDesc.IsLocal) {
+			configHost.value = "";
+		}
+		configHost.closest("p").classList.toggle("hide", clientDesc.IsLocal);
+
 		if (clientDesc.RequiresUser == TRISTATE_NO) {
 			configUser.value = "";
 		}
@@ -98,13 +116,16 @@
 		let hidePrivateKey = !(clientDesc.SupportedAuthTypes & AuthTypePrivateKeys);
 		configPrivateKey.closest("p").classList.toggle("hide", hidePrivateKey);
 
+		let hideSSHAgent = !(clientDesc.SupportedAuthTypes & AuthTypeSSHAgent);
+		configSSHAgent.closest("p").classList.toggle("hide", hideSSHAgent);
+
 		let hideKnownHosts = !clientDesc.RequiresKnownHosts;
 		configKnownHosts.closest("p").classList.toggle("hide", hideKnownHosts);
 
 		let hideOptions = !clientDesc.Options;
 		configOptions.classList.toggle("hide", hideOptions);
 
-		configAdvanced.classList.toggle("hide", hidePrivateKey && hideKnownHosts && hideOptions);
+		configAdvanced.classList.toggle("hide", hidePrivateKey && hideSSHAgent && hideKnownHosts && hideOptions);
 
 		let existingOptionItems = {};
 		while (configOptions.firstChild) {
@@ -175,6 +196,7 @@
 			"Host": configHost.value,
 			"User": configUser.value,
 			"PrivateKeysPath": configPrivateKey.value,
+			"UseSSHAgent": configSSHAgent.checked,
 			"KnownHostsPath": configKnownHosts.value,
 			"Options": {}
 		};
@@ -227,6 +249,71 @@
 		element.tabIndex = disabled ? -1 : 0;
 	}
 
+	function updateSummary(html) {
+		var openDetails = [];
+		for (let item of summary.querySelectorAll("details[open]")) {
+			openDetails.push(item.id);
+		}
+
+		summary.innerHTML = html;
+
+		for (let id of openDetails) {
+			let item = document.getElementById(id);
+			if (item) {
+				item.open = true;
+			}
+		}
+	}
+
+	function updateLine() {
+		var lines = lastData["lines"];
+		if (selectedLine >= lines.length) {
+			selectedLine = 0;
+		}
+		var line = lines[selectedLine];
+
+		bins = DSLGraphs.decodeBins(line["bins"]);
+		binsHistory = DSLGraphs.decodeBinsHistory(line["bins_history"]);
+		var errorsHistory = DSLGraphs.decodeErrorsHistory(line["errors_history"]);
+		updateSummary((lastData["bonding"] || "") + line["summary"] + (lastData["connection"] || ""));
+		for (let item of summary.querySelectorAll("[data-line]")) {
+			item.classList.toggle("selected", item.dataset.line == selectedLine);
+		}
+		graphBits.setData(bins);
+		updateSNRGraph();
+		graphQLN.setData(bins);
+		graphHlog.setData(bins);
+		graphPSD.setData(bins);
+		graphFEXTCancellation.setData(bins);
+		graphFEXTCancellationSection.hidden = bins.FEXTCancellation.Downstream.Data.length == 0 &&
+			bins.FEXTCancellation.Upstream.Data.length == 0;
+		graphHlin.setData(bins);
+		graphHlinSection.hidden = bins.Hlin.Downstream.Data.length == 0 &&
+			bins.Hlin.Upstream.Data.length == 0;
+		graphGains.setData(bins);
+		graphGainsSection.hidden = bins.Gains.Downstream.Data.length == 0 &&
+			bins.Gains.Upstream.Data.length == 0;
+		graphRetransmissionDown.setData(errorsHistory);
+		graphRetransmissionUp.setData(errorsHistory);
+		graphErrorsDown.setData(errorsHistory);
+		graphErrorsUp.setData(errorsHistory);
+		graphErrorSecondsDown.setData(errorsHistory);
+		graphErrorSecondsUp.setData(errorsHistory);
+		graphFailureSecondsDown.setData(errorsHistory);
+		graphFailureSecondsUp.setData(errorsHistory);
+		graphFullInits.setData(errorsHistory);
+		graphRetrains.setData(errorsHistory);
+	}
+
+	function selectLine(event) {
+		var item = event.target.closest("[data-line]");
+		if (item) {
+			event.preventDefault();
+			selectedLine = parseInt(item.dataset.line);
+			updateLine();
+		}
+	}
+
 	function updateState(newState, info, data) {
 		let oldState = state;
 
@@ -237,6 +324,11 @@
 					fingerprint.innerText = info;
 					break;
 
+				case STATE_HOSTKEY:
+					hostKeyHost.innerText = info["host"];
+					hostKeyFingerprint.innerText = info["fingerprint"];
+					break;
+
 				case STATE_ERROR:
 					overlayError.innerText = info;
 					break;
@@ -245,20 +337,8 @@
 		}
 
 		if (data !== undefined) {
-			bins = DSLGraphs.decodeBins(data["bins"]);
-			binsHistory = DSLGraphs.decodeBinsHistory(data["bins_history"]);
-			var errorsHistory = DSLGraphs.decodeErrorsHistory(data["errors_history"]);
-			summary.innerHTML = data["summary"];
-			graphBits.setData(bins);
-			updateSNRGraph();
-			graphQLN.setData(bins);
-			graphHlog.setData(bins);
-			graphRetransmissionDown.setData(errorsHistory);
-			graphRetransmissionUp.setData(errorsHistory);
-			graphErrorsDown.setData(errorsHistory);
-			graphErrorsUp.setData(errorsHistory);
-			graphErrorSecondsDown.setData(errorsHistory);
-			graphErrorSecondsUp.setData(errorsHistory);
+			lastData = data;
+			updateLine();
 		}
 
 		if (newState != oldState) {
@@ -266,7 +346,7 @@
 
 			setLinkDisabled(buttonSave, data === undefined);
 			setLinkDisabled(buttonDisconnect,
-				state != STATE_READY && state != STATE_PASSWORD && state != STATE_PASSPHRASE && state != STATE_ENCRYPTION_PASSPHRASE && state != STATE_ERROR && state != STATE_LOADING);
+				state != STATE_READY && state != STATE_PASSWORD && state != STATE_PASSPHRASE && state != STATE_ENCRYPTION_PASSPHRASE && state != STATE_HOSTKEY && state != STATE_ERROR && state != STATE_LOADING);
 
 			checkboxAutoscale.disabled = state != STATE_READY;
 			checkboxMinMax.disabled = state != STATE_READY;
@@ -275,6 +355,7 @@
 			overlayPassword.classList.toggle("visible", state == STATE_PASSWORD);
 			overlayPassphrase.classList.toggle("visible", state == STATE_PASSPHRASE);
 			overlayEncryptionPassphrase.classList.toggle("visible", state == STATE_ENCRYPTION_PASSPHRASE);
+			overlayHostKey.classList.toggle("visible", state == STATE_HOSTKEY);
 			overlayError.classList.toggle("visible", state == STATE_ERROR);
 			overlayLoading.classList.toggle("visible", state == STATE_LOADING || state == STATE_INITIALIZING);
 			overlayDisconnecting.classList.toggle("visible", state == STATE_DISCONNECTING);
@@ -313,6 +394,9 @@
 	function sendForm(event) {
 		let form = event.target;
 		let formData = new FormData(form);
+		if (event.submitter && event.submitter.name) {
+			formData.append(event.submitter.name, event.submitter.value);
+		}
 		form.reset();
 
 		updateState("loading");
@@ -324,7 +408,7 @@
 	}
 
 	function initForms() {
-		let forms = document.querySelectorAll("#overlay-password, #overlay-passphrase, #overlay-encryption-passphrase");
+		let forms = document.querySelectorAll("#overlay-password, #overlay-passphrase, #overlay-encryption-passphrase, #overlay-hostkey");
 
 		for (let form of forms) {
 			form.addEventListener("submit", sendForm);
@@ -370,6 +454,16 @@
 
 		graphHlog.setParams(params);
 		graphHlogCanvas.style.width = width;
+
+		graphPSD.setParams(params);
+		graphPSDCanvas.style.width = width;
+
+		graphFEXTCancellation.setParams(params);
+		graphFEXTCancellationCanvas.style.width = width;
+		graphHlin.setParams(params);
+		graphHlinCanvas.style.width = width;
+		graphGains.setParams(params);
+		graphGainsCanvas.style.width = width;
 	}
 
 	function applyErrorsGraphParams(params) {
@@ -392,6 +486,18 @@
 
 		graphErrorSecondsUp.setParams(params);
 		graphErrorSecondsUpCanvas.style.width = width;
+
+		graphFailureSecondsDown.setParams(params);
+		graphFailureSecondsDownCanvas.style.width = width;
+
+		graphFailureSecondsUp.setParams(params);
+		graphFailureSecondsUpCanvas.style.width = width;
+
+		graphFullInits.setParams(params);
+		graphFullInitsCanvas.style.width = width;
+
+		graphRetrains.setParams(params);
+		graphRetrainsCanvas.style.width = width;
 	}
 
 	function initGraphs() {
@@ -399,6 +505,13 @@
 		graphSNRCanvas = document.getElementById("graph_snr");
 		graphQLNCanvas = document.getElementById("graph_qln");
 		graphHlogCanvas = document.getElementById("graph_hlog");
+		graphPSDCanvas = document.getElementById("graph_psd");
+		graphFEXTCancellationCanvas = document.getElementById("graph_fext");
+		graphFEXTCancellationSection = document.getElementById("graph_fext_section");
+		graphHlinCanvas = document.getElementById("graph_hlin");
+		graphHlinSection = document.getElementById("graph_hlin_section");
+		graphGainsCanvas = document.getElementById("graph_gains");
+		graphGainsSection = document.getElementById("graph_gains_section");
 
 		graphRetransmissionDownCanvas = document.getElementById("graph_retransmission_ds");
 		graphRetransmissionUpCanvas = document.getElementById("graph_retransmission_us");
@@ -406,6 +519,10 @@
 		graphErrorsUpCanvas = document.getElementById("graph_errors_us");
 		graphErrorSecondsDownCanvas = document.getElementById("graph_errorseconds_ds");
 		graphErrorSecondsUpCanvas = document.getElementById("graph_errorseconds_us");
+		graphFailureSecondsDownCanvas = document.getElementById("graph_failureseconds_ds");
+		graphFailureSecondsUpCanvas = document.getElementById("graph_failureseconds_us");
+		graphFullInitsCanvas = document.getElementById("graph_fullinits");
+		graphRetrainsCanvas = document.getElementById("graph_retrains");
 
 		var defaultParams = new DSLGraphs.GraphParams();
 
@@ -413,6 +530,10 @@
 		graphSNR = new DSLGraphs.SNRGraph(graphSNRCanvas, defaultParams);
 		graphQLN = new DSLGraphs.QLNGraph(graphQLNCanvas, defaultParams);
 		graphHlog = new DSLGraphs.HlogGraph(graphHlogCanvas, defaultParams);
+		graphPSD = new DSLGraphs.PSDGraph(graphPSDCanvas, defaultParams);
+		graphFEXTCancellation = new DSLGraphs.FEXTCancellationGraph(graphFEXTCancellationCanvas, defaultParams);
+		graphHlin = new DSLGraphs.HlinGraph(graphHlinCanvas, defaultParams);
+		graphGains = new DSLGraphs.GainsGraph(graphGainsCanvas, defaultParams);
 
 		graphRetransmissionDown = new DSLGraphs.DownstreamRetransmissionGraph(graphRetransmissionDownCanvas, defaultParams);
 		graphRetransmissionUp = new DSLGraphs.UpstreamRetransmissionGraph(graphRetransmissionUpCanvas, defaultParams);
@@ -420,6 +541,10 @@
 		graphErrorsUp = new DSLGraphs.UpstreamErrorsGraph(graphErrorsUpCanvas, defaultParams);
 		graphErrorSecondsDown = new DSLGraphs.DownstreamErrorSecondsGraph(graphErrorSecondsDownCanvas, defaultParams);
 		graphErrorSecondsUp = new DSLGraphs.UpstreamErrorSecondsGraph(graphErrorSecondsUpCanvas, defaultParams);
+		graphFailureSecondsDown = new DSLGraphs.DownstreamFailureSecondsGraph(graphFailureSecondsDownCanvas, defaultParams);
+		graphFailureSecondsUp = new DSLGraphs.UpstreamFailureSecondsGraph(graphFailureSecondsUpCanvas, defaultParams);
+		graphFullInits = new DSLGraphs.FullInitsGraph(graphFullInitsCanvas, defaultParams);
+		graphRetrains = new DSLGraphs.RetrainsGraph(graphRetrainsCanvas, defaultParams);
 
 		var lastDevicePixelRatio = 0;
 		var lastWidth = 0;
@@ -478,6 +603,7 @@
 		buttonDisconnect = document.getElementById("button-disconnect");
 
 		summary = document.getElementById("summary");
+		summary.addEventListener("click", selectLine);
 		graphs = document.getElementById("graphs");
 		errors = document.getElementById("errors");
 
@@ -488,6 +614,7 @@
 		overlayPassword = document.getElementById("overlay-password");
 		overlayPassphrase = document.getElementById("overlay-passphrase");
 		overlayEncryptionPassphrase = document.getElementById("overlay-encryption-passphrase");
+		overlayHostKey = document.getElementById("overlay-hostkey");
 		overlayError = document.getElementById("overlay-error");
 		overlayLoading = document.getElementById("overlay-loading");
 		overlayDisconnecting = document.getElementById("overlay-disconnecting");
@@ -498,6 +625,7 @@
 		configHost = document.getElementById("config-host");
 		configUser = document.getElementById("config-user");
 		configPrivateKey = document.getElementById("config-private-key");
+		configSSHAgent = document.getElementById("config-ssh-agent");
 		configKnownHosts = document.getElementById("config-known-hosts");
 		configOptions = document.getElementById("config-options");
 		configRemember = document.getElementById("config-remember");
@@ -505,6 +633,8 @@
 		messages = document.getElementById("messages");
 
 		fingerprint = document.getElementById("fingerprint");
+		hostKeyHost = document.getElementById("hostkey-host");
+		hostKeyFingerprint = document.getElementById("hostkey-fingerprint");
 		inputPassword = document.getElementById("password");
 		inputPassphrase = document.getElementById("passphrase");
 		inputEncryptionPassphrase = document.getElementById("encryption-passphrase");
@@ -94,14 +94,28 @@ func main() {
 	flagSet.Var(&privateKey, "private-key", "private key file for SSH authentication")
 	flagSet.Lookup("private-key").DefValue = privateKey.Value
 
+	var sshAgent bool
+	flagSet.BoolVar(&sshAgent, "ssh-agent", false, "use keys from the SSH agent (SSH_AUTH_SOCK) for SSH authentication")
+	flagSet.Lookup("ssh-agent").DefValue = ""
+
 	knownHosts := stringFlag{Value: config.DefaultKnownHostsPath}
 	flagSet.Var(&knownHosts, "known-hosts", "known hosts file for SSH host key validation, validation is skipped if set to \"IGNORE\"")
 	flagSet.Lookup("known-hosts").DefValue = knownHosts.Value
 
+	var jumpHost stringFlag
+	flagSet.Var(&jumpHost, "jump-host", "SSH jump host to connect through, optionally including port (uses the same private key and known hosts file)")
+
+	var jumpHostUser stringFlag
+	flagSet.Var(&jumpHostUser, "jump-user", "user name for the SSH jump host")
+
 	var startWebServer bool
 	flagSet.BoolVar(&startWebServer, "web", false, "start web server")
 	flagSet.Lookup("web").DefValue = ""
 
+	var probeDevice bool
+	flagSet.BoolVar(&probeDevice, "probe", false, "detect likely device types for the given host")
+	flagSet.Lookup("probe").DefValue = ""
+
 	var startGUI bool
 	if gui.Enabled {
 		flagSet.BoolVar(&startGUI, "gui", false, "start graphical user interface")
@@ -130,6 +144,10 @@ func main() {
 		exitWithUsage(flagSet, "Web interface and GUI cannot be selected together.")
 	}
 
+	if probeDevice && (startWebServer || startGUI) {
+		exitWithUsage(flagSet, "Probing cannot be combined with web interface or GUI.")
+	}
+
 	err = config.Load(configPath)
 	if err != nil {
 		fmt.Println(err)
@@ -163,10 +181,22 @@ func main() {
 		config.Config.PrivateKeyPath = privateKey.String()
 	}
 
+	if sshAgent {
+		config.Config.UseSSHAgent = true
+	}
+
 	if knownHosts.Valid {
 		config.Config.KnownHostsPath = knownHosts.String()
 	}
 
+	if jumpHost.Valid {
+		config.Config.JumpHost = jumpHost.String()
+	}
+
+	if jumpHostUser.Valid {
+		config.Config.JumpHostUser = jumpHostUser.String()
+	}
+
 	for k, v := range options {
 		if v != "" {
 			config.Config.Options[k] = v
@@ -175,7 +205,13 @@ func main() {
 		}
 	}
 
-	if gui.Enabled && (startGUI || len(os.Args) == 1) {
+	if probeDevice {
+		if config.Config.Host == "" {
+			exitWithUsage(flagSet, "No hostname specified.")
+		}
+
+		cli.Probe(config.Config.Host)
+	} else if gui.Enabled && (startGUI || len(os.Args) == 1) {
 		gui.Run(stateDir)
 	} else {
 		err = config.Validate()
@@ -282,10 +318,13 @@ func printUsage(flagSet *flag.FlagSet) {
 
 func printHelp(flagSet *flag.FlagSet) {
 	fmt.Print("\nUsage:")
+	indent := "        "
 	if len(flagSet.Name()) > 20 {
 		fmt.Println()
+		indent = "  "
 	}
-	fmt.Printf("  %s -d device [options] hostname\n\n", flagSet.Name())
+	fmt.Printf("  %s -d device [options] hostname\n", flagSet.Name())
+	fmt.Printf("%s%s -probe hostname\n\n", indent, flagSet.Name())
 
 	fmt.Println("List of options:")
 	fmt.Println()
@@ -6,6 +6,7 @@ package common
 
 import (
 	"archive/zip"
+	"fmt"
 	"io"
 
 	"3e8.eu/go/dsl/graphs"
@@ -21,13 +22,27 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 
 	var fileWriter io.Writer
 
-	fileWriter, err = archive.Create(filenameBase + "_summary.txt")
-	if err != nil {
-		return
+	for i, line := range state.Lines {
+		lineFilenameBase := filenameBase
+		if i != 0 {
+			lineFilenameBase = fmt.Sprintf("%s_line%d", filenameBase, i+1)
+		}
+
+		err = writeArchiveLine(archive, lineFilenameBase, line)
+		if err != nil {
+			return
+		}
 	}
-	_, err = io.WriteString(fileWriter, state.Status.Summary())
-	if err != nil {
-		return
+
+	if len(state.Lines) > 1 {
+		fileWriter, err = archive.Create(filenameBase + "_bonding.txt")
+		if err != nil {
+			return
+		}
+		_, err = io.WriteString(fileWriter, getBondingStatus(state.Lines).Summary())
+		if err != nil {
+			return
+		}
 	}
 
 	if rawData {
@@ -41,6 +56,21 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 		}
 	}
 
+	return
+}
+
+func writeArchiveLine(archive *zip.Writer, filenameBase string, line LineState) (err error) {
+	var fileWriter io.Writer
+
+	fileWriter, err = archive.Create(filenameBase + "_summary.txt")
+	if err != nil {
+		return
+	}
+	_, err = io.WriteString(fileWriter, line.Status.Summary())
+	if err != nil {
+		return
+	}
+
 	graphParamsScaled := graphs.DefaultGraphParamsWithLegend
 	graphParamsScaled.PreferDynamicAxisLimits = true
 
@@ -48,7 +78,7 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	err = graphs.DrawBitsGraph(fileWriter, state.Bins, graphs.DefaultGraphParamsWithLegend)
+	err = graphs.DrawBitsGraph(fileWriter, line.Bins, graphs.DefaultGraphParamsWithLegend)
 	if err != nil {
 		return
 	}
@@ -57,7 +87,7 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	err = graphs.DrawBitsGraph(fileWriter, state.Bins, graphParamsScaled)
+	err = graphs.DrawBitsGraph(fileWriter, line.Bins, graphParamsScaled)
 	if err != nil {
 		return
 	}
@@ -66,7 +96,7 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	err = graphs.DrawSNRGraph(fileWriter, state.Bins, graphs.DefaultGraphParamsWithLegend)
+	err = graphs.DrawSNRGraph(fileWriter, line.Bins, graphs.DefaultGraphParamsWithLegend)
 	if err != nil {
 		return
 	}
@@ -75,7 +105,7 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	err = graphs.DrawSNRGraph(fileWriter, state.Bins, graphParamsScaled)
+	err = graphs.DrawSNRGraph(fileWriter, line.Bins, graphParamsScaled)
 	if err != nil {
 		return
 	}
@@ -84,7 +114,7 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	err = graphs.DrawSNRGraphWithHistory(fileWriter, state.Bins, state.BinsHistory, graphs.DefaultGraphParamsWithLegend)
+	err = graphs.DrawSNRGraphWithHistory(fileWriter, line.Bins, line.BinsHistory, graphs.DefaultGraphParamsWithLegend)
 	if err != nil {
 		return
 	}
@@ -93,7 +123,7 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	err = graphs.DrawSNRGraphWithHistory(fileWriter, state.Bins, state.BinsHistory, graphParamsScaled)
+	err = graphs.DrawSNRGraphWithHistory(fileWriter, line.Bins, line.BinsHistory, graphParamsScaled)
 	if err != nil {
 		return
 	}
@@ -102,7 +132,7 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	err = graphs.DrawQLNGraph(fileWriter, state.Bins, graphs.DefaultGraphParamsWithLegend)
+	err = graphs.DrawQLNGraph(fileWriter, line.Bins, graphs.DefaultGraphParamsWithLegend)
 	if err != nil {
 		return
 	}
@@ -111,7 +141,7 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	err = graphs.DrawQLNGraph(fileWriter, state.Bins, graphParamsScaled)
+	err = graphs.DrawQLNGraph(fileWriter, line.Bins, graphParamsScaled)
 	if err != nil {
 		return
 	}
@@ -120,7 +150,7 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	err = graphs.DrawHlogGraph(fileWriter, state.Bins, graphs.DefaultGraphParamsWithLegend)
+	err = graphs.DrawHlogGraph(fileWriter, line.Bins, graphs.DefaultGraphParamsWithLegend)
 	if err != nil {
 		return
 	}
@@ -129,16 +159,69 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	err = graphs.DrawHlogGraph(fileWriter, state.Bins, graphParamsScaled)
+	err = graphs.DrawHlogGraph(fileWriter, line.Bins, graphParamsScaled)
 	if err != nil {
 		return
 	}
 
+	if len(line.Bins.PSD.Downstream.Data) != 0 || len(line.Bins.PSD.Upstream.Data) != 0 {
+		fileWriter, err = archive.Create(filenameBase + "_psd.svg")
+		if err != nil {
+			return
+		}
+		err = graphs.DrawPSDGraph(fileWriter, line.Bins, graphs.DefaultGraphParamsWithLegend)
+		if err != nil {
+			return
+		}
+
+		fileWriter, err = archive.Create(filenameBase + "_psd_scaled.svg")
+		if err != nil {
+			return
+		}
+		err = graphs.DrawPSDGraph(fileWriter, line.Bins, graphParamsScaled)
+		if err != nil {
+			return
+		}
+	}
+
+	if len(line.Bins.Hlin.Downstream.Real) != 0 || len(line.Bins.Hlin.Upstream.Real) != 0 {
+		fileWriter, err = archive.Create(filenameBase + "_hlin.svg")
+		if err != nil {
+			return
+		}
+		err = graphs.DrawHlinGraph(fileWriter, line.Bins, graphs.DefaultGraphParamsWithLegend)
+		if err != nil {
+			return
+		}
+	}
+
+	if len(line.Bins.Gains.Downstream.Data) != 0 || len(line.Bins.Gains.Upstream.Data) != 0 {
+		fileWriter, err = archive.Create(filenameBase + "_gains.svg")
+		if err != nil {
+			return
+		}
+		err = graphs.DrawGainsGraph(fileWriter, line.Bins, graphs.DefaultGraphParamsWithLegend)
+		if err != nil {
+			return
+		}
+	}
+
+	if len(line.Bins.FEXTCancellation.Downstream.Data) != 0 || len(line.Bins.FEXTCancellation.Upstream.Data) != 0 {
+		fileWriter, err = archive.Create(filenameBase + "_fext.svg")
+		if err != nil {
+			return
+		}
+		err = graphs.DrawFEXTCancellationGraph(fileWriter, line.Bins, graphs.DefaultGraphParamsWithLegend)
+		if err != nil {
+			return
+		}
+	}
+
 	fileWriter, err = archive.Create(filenameBase + "_errors_retransmission_ds.svg")
 	if err != nil {
 		return
 	}
-	err = graphs.DrawDownstreamRetransmissionGraph(fileWriter, state.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
+	err = graphs.DrawDownstreamRetransmissionGraph(fileWriter, line.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
 	if err != nil {
 		return
 	}
@@ -147,7 +230,7 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	err = graphs.DrawUpstreamRetransmissionGraph(fileWriter, state.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
+	err = graphs.DrawUpstreamRetransmissionGraph(fileWriter, line.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
 	if err != nil {
 		return
 	}
@@ -156,7 +239,7 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	err = graphs.DrawDownstreamErrorsGraph(fileWriter, state.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
+	err = graphs.DrawDownstreamErrorsGraph(fileWriter, line.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
 	if err != nil {
 		return
 	}
@@ -165,7 +248,7 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	err = graphs.DrawUpstreamErrorsGraph(fileWriter, state.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
+	err = graphs.DrawUpstreamErrorsGraph(fileWriter, line.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
 	if err != nil {
 		return
 	}
@@ -174,7 +257,7 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	err = graphs.DrawDownstreamErrorSecondsGraph(fileWriter, state.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
+	err = graphs.DrawDownstreamErrorSecondsGraph(fileWriter, line.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
 	if err != nil {
 		return
 	}
@@ -183,7 +266,43 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	err = graphs.DrawUpstreamErrorSecondsGraph(fileWriter, state.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
+	err = graphs.DrawUpstreamErrorSecondsGraph(fileWriter, line.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
+	if err != nil {
+		return
+	}
+
+	fileWriter, err = archive.Create(filenameBase + "_errors_failures_ds.svg")
+	if err != nil {
+		return
+	}
+	err = graphs.DrawDownstreamFailureSecondsGraph(fileWriter, line.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
+	if err != nil {
+		return
+	}
+
+	fileWriter, err = archive.Create(filenameBase + "_errors_failures_us.svg")
+	if err != nil {
+		return
+	}
+	err = graphs.DrawUpstreamFailureSecondsGraph(fileWriter, line.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
+	if err != nil {
+		return
+	}
+
+	fileWriter, err = archive.Create(filenameBase + "_errors_fullinits.svg")
+	if err != nil {
+		return
+	}
+	err = graphs.DrawFullInitsGraph(fileWriter, line.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
+	if err != nil {
+		return
+	}
+
+	fileWriter, err = archive.Create(filenameBase + "_errors_retrains.svg")
+	if err != nil {
+		return
+	}
+	err = graphs.DrawRetrainsGraph(fileWriter, line.ErrorsHistory, graphs.DefaultGraphParamsWithLegend)
 	if err != nil {
 		return
 	}
@@ -192,7 +311,7 @@ func WriteArchive(w io.Writer, filenameBase string, state StateChange, rawData b
 	if err != nil {
 		return
 	}
-	_, err = io.WriteString(fileWriter, state.ErrorsHistory.String())
+	_, err = io.WriteString(fileWriter, line.ErrorsHistory.String())
 	if err != nil {
 		return
 	}
@@ -6,6 +6,7 @@ package common
 
 import (
 	"errors"
+	"log"
 	"time"
 
 	"3e8.eu/go/dsl"
@@ -20,6 +21,7 @@ const (
 	StatePasswordRequired             State = "password"
 	StatePassphraseRequired           State = "passphrase"
 	StateEncryptionPassphraseRequired State = "encryption-passphrase"
+	StateHostKeyConfirmationRequired  State = "hostkey"
 	StateLoading                      State = "loading"
 	StateError                        State = "error"
 )
@@ -33,23 +35,37 @@ const (
 type StateChange struct {
 	State State
 
-	HasData       bool
-	Time          time.Time
-	RawData       []byte
+	HasData bool
+	Time    time.Time
+	RawData []byte
+	Lines   []LineState
+
+	ConnectionStats dsl.ConnectionStats
+
+	Host        string
+	Fingerprint string
+
+	Err error
+}
+
+// LineState contains the data of a single line, the first line is the main line of the device
+type LineState struct {
 	Status        models.Status
 	Bins          models.Bins
 	BinsHistory   models.BinsHistory
 	ErrorsHistory models.ErrorsHistory
+}
 
-	Fingerprint string
-
-	Err error
+type lineHistory struct {
+	bins   *history.Bins
+	errors *history.Errors
 }
 
 type Client struct {
 	setPassword             chan string
 	setPassphrase           chan string
 	setEncryptionPassphrase chan string
+	confirmHostKey          chan bool
 	changeState             chan StateChange
 	registerReceiver        chan chan StateChange
 	unregisterReceiver      chan chan StateChange
@@ -70,10 +86,15 @@ type Client struct {
 
 	errCount int
 
+	// connectionStats contains the reconnects of clients which have been closed already, the stats of the
+	// current client are added by getConnectionStats
+	connectionStats dsl.ConnectionStats
+
 	config               dsl.Config
 	password             string
 	passphrase           map[string]string
 	encryptionPassphrase string
+	trustedHostKeys      map[string]bool
 
 	stateDir string
 }
@@ -83,6 +104,7 @@ func NewClient(config dsl.Config, stateDir string) *Client {
 		setPassword:             make(chan string),
 		setPassphrase:           make(chan string),
 		setEncryptionPassphrase: make(chan string),
+		confirmHostKey:          make(chan bool),
 		changeState:             make(chan StateChange),
 		registerReceiver:        make(chan chan StateChange),
 		unregisterReceiver:      make(chan chan StateChange),
@@ -95,6 +117,7 @@ func NewClient(config dsl.Config, stateDir string) *Client {
 		done:                    make(chan bool),
 		config:                  config,
 		passphrase:              make(map[string]string),
+		trustedHostKeys:         make(map[string]bool),
 		stateDir:                stateDir,
 	}
 
@@ -139,6 +162,15 @@ func (c *Client) SetEncryptionPassphrase(encryptionPassphrase string) error {
 	}
 }
 
+func (c *Client) ConfirmHostKey(trust bool) error {
+	select {
+	case c.confirmHostKey <- trust:
+		return nil
+	default:
+		return errors.New("no host key confirmation required")
+	}
+}
+
 func (c *Client) RegisterReceiver(receiver chan StateChange) {
 	c.registerReceiver <- receiver
 }
@@ -203,14 +235,40 @@ func (c *Client) stateChangeWithLastData(change StateChange) StateChange {
 	change.HasData = c.lastData.HasData
 	change.Time = c.lastData.Time
 	change.RawData = c.lastData.RawData
-	change.Status = c.lastData.Status
-	change.Bins = c.lastData.Bins
-	change.BinsHistory = c.lastData.BinsHistory
-	change.ErrorsHistory = c.lastData.ErrorsHistory
+	change.Lines = c.lastData.Lines
+	change.ConnectionStats = c.lastData.ConnectionStats
 
 	return change
 }
 
+func (c *Client) getConnectionStats() dsl.ConnectionStats {
+	stats := c.connectionStats
+
+	if c.client != nil {
+		clientStats := dsl.GetConnectionStats(c.client)
+		stats.Reconnects += clientStats.Reconnects
+		stats.FailedReconnects += clientStats.FailedReconnects
+		if clientStats.LastReconnect.After(stats.LastReconnect) {
+			stats.LastReconnect = clientStats.LastReconnect
+		}
+	}
+
+	return stats
+}
+
+func (c *Client) closeClient() {
+	c.connectionStats = c.getConnectionStats()
+
+	c.client.Close()
+	c.client = nil
+}
+
+func (c *Client) resetCredentials() {
+	c.password = ""
+	c.passphrase = make(map[string]string)
+	c.encryptionPassphrase = ""
+}
+
 func (c *Client) connect() {
 	var err error
 	var interval = 2 * time.Second
@@ -227,9 +285,7 @@ func (c *Client) connect() {
 
 		var authErr *dsl.AuthenticationError
 		if errors.As(err, &authErr) {
-			c.password = ""
-			c.passphrase = make(map[string]string)
-			c.encryptionPassphrase = ""
+			c.resetCredentials()
 
 			if authErr.WaitTime > interval {
 				interval = authErr.WaitTime
@@ -322,17 +378,47 @@ func (c *Client) update() {
 		}
 	}
 
-	binsHistory, err := history.NewBins(history.DefaultBinsConfig)
-	if err != nil {
-		panic(err)
+	if c.config.UnknownHostKey != nil {
+		addHostKey := c.config.UnknownHostKey
+		c.config.UnknownHostKey = func(host, fingerprint, knownHostsLine string) error {
+			if !c.trustedHostKeys[knownHostsLine] {
+				c.changeState <- c.stateChangeWithLastData(
+					StateChange{State: StateHostKeyConfirmationRequired, Host: host, Fingerprint: fingerprint})
+
+				select {
+				case <-c.cancel:
+					c.canceled = true
+					return errors.New("canceled")
+				case trust := <-c.confirmHostKey:
+					if !trust {
+						return errors.New("host key not trusted")
+					}
+				}
+
+				c.changeState <- c.stateChangeWithLastData(
+					StateChange{State: StateLoading})
+
+				err := addHostKey(host, fingerprint, knownHostsLine)
+				if err != nil {
+					return err
+				}
+
+				c.trustedHostKeys[knownHostsLine] = true
+			}
+
+			return nil
+		}
 	}
 
-	errorsHistory, err := history.NewErrors(history.DefaultErrorsConfig)
-	if err != nil {
-		panic(err)
+	// only key-based authentication is supported interactively for the jump host
+	if c.config.JumpHost != nil && c.config.JumpHost.AuthPrivateKeys.Passphrase == nil {
+		c.config.JumpHost.AuthPrivateKeys.Passphrase = c.config.AuthPrivateKeys.Passphrase
+	}
+	if c.config.JumpHost != nil && c.config.JumpHost.UnknownHostKey != nil {
+		c.config.JumpHost.UnknownHostKey = c.config.UnknownHostKey
 	}
 
-	c.loadHistory(binsHistory, errorsHistory)
+	var histories []lineHistory
 	nextSave := time.Now().Truncate(intervalSave).Add(intervalSave)
 
 mainloop:
@@ -351,17 +437,36 @@ mainloop:
 
 				now := time.Now()
 
-				binsHistory.Update(c.client.Status(), c.client.Bins(), now)
-				errorsHistory.Update(c.client.Status(), now)
+				lines := dsl.GetLines(c.client)
+				for len(histories) < len(lines) {
+					histories = append(histories, c.newLineHistory(len(histories)))
+				}
+
+				lineStates := make([]LineState, len(lines))
+				for i, line := range lines {
+					histories[i].bins.Update(line.Status, line.Bins, now)
+					histories[i].errors.Update(line.Status, now)
+
+					lineStates[i] = LineState{
+						Status:        line.Status,
+						Bins:          line.Bins,
+						BinsHistory:   histories[i].bins.Data(),
+						ErrorsHistory: histories[i].errors.Data(),
+					}
+				}
+
+				connectionStats := c.getConnectionStats()
+				if connectionStats.Reconnects > c.lastData.ConnectionStats.Reconnects {
+					log.Printf("reconnected to device (%d reconnects, %d failed)",
+						connectionStats.Reconnects, connectionStats.FailedReconnects)
+				}
 
 				c.lastData = StateChange{
-					HasData:       true,
-					Time:          now,
-					RawData:       c.client.RawData(),
-					Status:        c.client.Status(),
-					Bins:          c.client.Bins(),
-					BinsHistory:   binsHistory.Data(),
-					ErrorsHistory: errorsHistory.Data(),
+					HasData:         true,
+					Time:            now,
+					RawData:         c.client.RawData(),
+					Lines:           lineStates,
+					ConnectionStats: connectionStats,
 				}
 
 				c.changeState <- c.stateChangeWithLastData(
@@ -370,7 +475,7 @@ mainloop:
 				c.errCount = 0
 
 				if now.After(nextSave) {
-					c.saveHistory(binsHistory, errorsHistory)
+					c.saveHistories(histories)
 					nextSave = time.Now().Truncate(intervalSave).Add(intervalSave)
 				}
 
@@ -383,10 +488,16 @@ mainloop:
 
 				c.errCount++
 
-				var connErr *dsl.ConnectionError
-				if errors.As(err, &connErr) || c.errCount == 10 {
-					c.client.Close()
-					c.client = nil
+				// the client reconnects by itself after connection errors, so the credentials only need to be
+				// reset if that failed because of them
+				var authErr *dsl.AuthenticationError
+				if errors.As(err, &authErr) {
+					c.resetCredentials()
+				}
+
+				// the client is only recreated if updates keep failing for other reasons
+				if c.errCount == 10 {
+					c.closeClient()
 				} else {
 					break
 				}
@@ -411,7 +522,7 @@ mainloop:
 	}
 
 	if c.lastData.HasData {
-		c.saveHistory(binsHistory, errorsHistory)
+		c.saveHistories(histories)
 	}
 
 	if c.client != nil {
@@ -422,6 +533,28 @@ mainloop:
 	c.done <- true
 }
 
+func (c *Client) newLineHistory(line int) lineHistory {
+	binsHistory, err := history.NewBins(history.DefaultBinsConfig)
+	if err != nil {
+		panic(err)
+	}
+
+	errorsHistory, err := history.NewErrors(history.DefaultErrorsConfig)
+	if err != nil {
+		panic(err)
+	}
+
+	c.loadHistory(line, binsHistory, errorsHistory)
+
+	return lineHistory{bins: binsHistory, errors: errorsHistory}
+}
+
+func (c *Client) saveHistories(histories []lineHistory) {
+	for i, h := range histories {
+		c.saveHistory(i, h.bins, h.errors)
+	}
+}
+
 func (c *Client) Close() {
 	c.cancel <- true
 	<-c.done
@@ -10,12 +10,18 @@ import (
 
 func GetGraphTemplateData() interface{} {
 	return map[string]interface{}{
-		"LegendBits":           graphs.GetBitsGraphLegend().Items,
-		"LegendSNR":            graphs.GetSNRGraphWithHistoryLegend().Items,
-		"LegendQLN":            graphs.GetQLNGraphLegend().Items,
-		"LegendHlog":           graphs.GetHlogGraphLegend().Items,
-		"LegendRetransmission": graphs.GetDownstreamRetransmissionGraphLegend().Items,
-		"LegendErrors":         graphs.GetDownstreamErrorsGraphLegend().Items,
-		"LegendErrorSeconds":   graphs.GetDownstreamErrorSecondsGraphLegend().Items,
+		"LegendBits":             graphs.GetBitsGraphLegend().Items,
+		"LegendSNR":              graphs.GetSNRGraphWithHistoryLegend().Items,
+		"LegendQLN":              graphs.GetQLNGraphLegend().Items,
+		"LegendHlog":             graphs.GetHlogGraphLegend().Items,
+		"LegendHlin":             graphs.GetHlinGraphLegend().Items,
+		"LegendPSD":              graphs.GetPSDGraphLegend().Items,
+		"LegendGains":            graphs.GetGainsGraphLegend().Items,
+		"LegendFEXTCancellation": graphs.GetFEXTCancellationGraphLegend().Items,
+		"LegendRetransmission":   graphs.GetDownstreamRetransmissionGraphLegend().Items,
+		"LegendErrors":           graphs.GetDownstreamErrorsGraphLegend().Items,
+		"LegendErrorSeconds":     graphs.GetDownstreamErrorSecondsGraphLegend().Items,
+		"LegendFailureSeconds":   graphs.GetDownstreamFailureSecondsGraphLegend().Items,
+		"LegendInitializations":  graphs.GetFullInitsGraphLegend().Items,
 	}
 }
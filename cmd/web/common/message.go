@@ -6,8 +6,10 @@ package common
 
 import (
 	"bytes"
+	"fmt"
 	"html/template"
 
+	"3e8.eu/go/dsl"
 	jsgraphs "3e8.eu/go/dsl/graphs/javascript"
 	"3e8.eu/go/dsl/models"
 )
@@ -21,6 +23,43 @@ func getSummaryString(status models.Status) string {
 	return buf.String()
 }
 
+func getBondingStatus(lines []LineState) models.BondingStatus {
+	modelLines := make([]models.Line, len(lines))
+	for i, line := range lines {
+		modelLines[i] = models.Line{Status: line.Status, Bins: line.Bins}
+	}
+	return models.NewBondingStatus(modelLines)
+}
+
+func getBondingString(lines []LineState) string {
+	buf := new(bytes.Buffer)
+
+	data := struct {
+		models.BondingStatus
+		LineNames []string
+	}{
+		BondingStatus: getBondingStatus(lines),
+		LineNames:     make([]string, len(lines)),
+	}
+	for i, line := range lines {
+		data.LineNames[i] = fmt.Sprintf("Line %d (%s)", i+1, line.Status.State)
+	}
+
+	tpl := template.Must(template.ParseFS(Files, "res/bonding.html"))
+	tpl.Execute(buf, data)
+
+	return buf.String()
+}
+
+func getConnectionString(stats dsl.ConnectionStats) string {
+	buf := new(bytes.Buffer)
+
+	tpl := template.Must(template.ParseFS(Files, "res/connection.html"))
+	tpl.Execute(buf, stats)
+
+	return buf.String()
+}
+
 func GetStateMessage(change StateChange) Message {
 	msg := Message{State: string(change.State)}
 
@@ -29,18 +68,37 @@ func GetStateMessage(change StateChange) Message {
 	case StatePassphraseRequired:
 		msg.Info = change.Fingerprint
 
+	case StateHostKeyConfirmationRequired:
+		msg.Info = MessageHostKey{Host: change.Host, Fingerprint: change.Fingerprint}
+
 	case StateError:
 		msg.Info = "failed to load data from device: " + change.Err.Error()
 
 	}
 
 	if change.HasData {
-		msg.Data = MessageData{
-			Summary:       getSummaryString(change.Status),
-			Bins:          jsgraphs.EncodeBins(change.Bins),
-			BinsHistory:   jsgraphs.EncodeBinsHistory(change.BinsHistory),
-			ErrorsHistory: jsgraphs.EncodeErrorsHistory(change.ErrorsHistory),
+		data := MessageData{
+			Lines: make([]MessageLineData, len(change.Lines)),
 		}
+
+		if len(change.Lines) > 1 {
+			data.Bonding = getBondingString(change.Lines)
+		}
+
+		if change.ConnectionStats.Reconnects != 0 || change.ConnectionStats.FailedReconnects != 0 {
+			data.Connection = getConnectionString(change.ConnectionStats)
+		}
+
+		for i, line := range change.Lines {
+			data.Lines[i] = MessageLineData{
+				Summary:       getSummaryString(line.Status),
+				Bins:          jsgraphs.EncodeBins(line.Bins),
+				BinsHistory:   jsgraphs.EncodeBinsHistory(line.BinsHistory),
+				ErrorsHistory: jsgraphs.EncodeErrorsHistory(line.ErrorsHistory),
+			}
+		}
+
+		msg.Data = data
 	}
 
 	return msg
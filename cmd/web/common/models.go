@@ -23,7 +23,22 @@ func (m Message) JSON() []byte {
 	return dataBytes
 }
 
+type MessageHostKey struct {
+	Host        string `json:"host"`
+	Fingerprint string `json:"fingerprint"`
+}
+
 type MessageData struct {
+	// Bonding is only set for devices with multiple lines
+	Bonding string `json:"bonding,omitempty"`
+
+	// Connection is only set if the connection to the device had to be reestablished
+	Connection string `json:"connection,omitempty"`
+
+	Lines []MessageLineData `json:"lines"`
+}
+
+type MessageLineData struct {
 	Summary       string          `json:"summary"`
 	Bins          json.RawMessage `json:"bins"`
 	BinsHistory   json.RawMessage `json:"bins_history"`
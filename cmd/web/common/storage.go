@@ -70,33 +70,41 @@ func (c *Client) writeStateFile(filename string, writeFunc func(w io.Writer) err
 	return
 }
 
-func (c *Client) loadHistory(bins *history.Bins, errors *history.Errors) {
+// getHistoryFilename returns the name of a history file, with a suffix for all lines except the first one
+func getHistoryFilename(name string, line int) string {
+	if line == 0 {
+		return name + ".dat.gz"
+	}
+	return fmt.Sprintf("%s_line%d.dat.gz", name, line+1)
+}
+
+func (c *Client) loadHistory(line int, bins *history.Bins, errors *history.Errors) {
 	if c.stateDir == "" {
 		return
 	}
 
-	err := c.readStateFile("bins.dat.gz", bins.Load)
+	err := c.readStateFile(getHistoryFilename("bins", line), bins.Load)
 	if err != nil && !os.IsNotExist(err) {
 		fmt.Println("failed to load bins history:", err)
 	}
 
-	err = c.readStateFile("errors.dat.gz", errors.Load)
+	err = c.readStateFile(getHistoryFilename("errors", line), errors.Load)
 	if err != nil && !os.IsNotExist(err) {
 		fmt.Println("failed to load errors history:", err)
 	}
 }
 
-func (c *Client) saveHistory(bins *history.Bins, errors *history.Errors) {
+func (c *Client) saveHistory(line int, bins *history.Bins, errors *history.Errors) {
 	if c.stateDir == "" {
 		return
 	}
 
-	err := c.writeStateFile("bins.dat.gz", bins.Save)
+	err := c.writeStateFile(getHistoryFilename("bins", line), bins.Save)
 	if err != nil {
 		fmt.Println("failed to save bins history:", err)
 	}
 
-	err = c.writeStateFile("errors.dat.gz", errors.Save)
+	err = c.writeStateFile(getHistoryFilename("errors", line), errors.Save)
 	if err != nil {
 		fmt.Println("failed to save errors history:", err)
 	}
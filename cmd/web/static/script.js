@@ -4,12 +4,15 @@ const STATE_READY = "ready";
 const STATE_PASSWORD = "password";
 const STATE_PASSPHRASE = "passphrase";
 const STATE_ENCRYPTION_PASSPHRASE = "encryption-passphrase";
+const STATE_HOSTKEY = "hostkey";
 const STATE_ERROR = "error";
 const STATE_LOADING = "loading";
 
 var state;
 var bins = null;
 var binsHistory = null;
+var lastData = null;
+var selectedLine = 0;
 
 var eventSource;
 
@@ -17,15 +20,22 @@ var linkSave;
 var summary, graphs, errors;
 var checkboxAutoscale, checkboxMinMax;
 var graphBitsCanvas, graphSNRCanvas, graphQLNCanvas, graphHlogCanvas,
+	graphPSDCanvas, graphFEXTCancellationCanvas, graphFEXTCancellationSection,
+	graphHlinCanvas, graphHlinSection, graphGainsCanvas, graphGainsSection,
 	graphRetransmissionDownCanvas, graphRetransmissionUpCanvas,
 	graphErrorsDownCanvas, graphErrorsUpCanvas,
-	graphErrorSecondsDownCanvas, graphErrorSecondsUpCanvas;
+	graphErrorSecondsDownCanvas, graphErrorSecondsUpCanvas,
+	graphFailureSecondsDownCanvas, graphFailureSecondsUpCanvas,
+	graphFullInitsCanvas, graphRetrainsCanvas;
 var graphBits, graphSNR, graphQLN, graphHlog,
+	graphPSD, graphFEXTCancellation, graphHlin, graphGains,
 	graphRetransmissionDown, graphRetransmissionUp,
 	graphErrorsDown, graphErrorsUp,
-	graphErrorSecondsDown, graphErrorSecondsUp;
-var overlay, overlayPassword, overlayPassphrase, overlayEncryptionPassphrase, overlayError, overlayLoading;
-var fingerprint, inputPassword, inputPassphrase, inputEncryptionPassphrase;
+	graphErrorSecondsDown, graphErrorSecondsUp,
+	graphFailureSecondsDown, graphFailureSecondsUp,
+	graphFullInits, graphRetrains;
+var overlay, overlayPassword, overlayPassphrase, overlayEncryptionPassphrase, overlayHostKey, overlayError, overlayLoading;
+var fingerprint, hostKeyHost, hostKeyFingerprint, inputPassword, inputPassphrase, inputEncryptionPassphrase;
 
 function setLinkDisabled(element, disabled) {
 	element.classList.toggle("disabled", disabled);
@@ -42,6 +52,71 @@ function setLinkDisabled(element, disabled) {
 	}
 }
 
+function updateSummary(html) {
+	var openDetails = [];
+	for (let item of summary.querySelectorAll("details[open]")) {
+		openDetails.push(item.id);
+	}
+
+	summary.innerHTML = html;
+
+	for (let id of openDetails) {
+		let item = document.getElementById(id);
+		if (item) {
+			item.open = true;
+		}
+	}
+}
+
+function updateLine() {
+	var lines = lastData["lines"];
+	if (selectedLine >= lines.length) {
+		selectedLine = 0;
+	}
+	var line = lines[selectedLine];
+
+	bins = DSLGraphs.decodeBins(line["bins"]);
+	binsHistory = DSLGraphs.decodeBinsHistory(line["bins_history"]);
+	var errorsHistory = DSLGraphs.decodeErrorsHistory(line["errors_history"]);
+	updateSummary((lastData["bonding"] || "") + line["summary"] + (lastData["connection"] || ""));
+	for (let item of summary.querySelectorAll("[data-line]")) {
+		item.classList.toggle("selected", item.dataset.line == selectedLine);
+	}
+	graphBits.setData(bins);
+	updateSNRGraph();
+	graphQLN.setData(bins);
+	graphHlog.setData(bins);
+	graphPSD.setData(bins);
+	graphFEXTCancellation.setData(bins);
+	graphFEXTCancellationSection.hidden = bins.FEXTCancellation.Downstream.Data.length == 0 &&
+		bins.FEXTCancellation.Upstream.Data.length == 0;
+	graphHlin.setData(bins);
+	graphHlinSection.hidden = bins.Hlin.Downstream.Data.length == 0 &&
+		bins.Hlin.Upstream.Data.length == 0;
+	graphGains.setData(bins);
+	graphGainsSection.hidden = bins.Gains.Downstream.Data.length == 0 &&
+		bins.Gains.Upstream.Data.length == 0;
+	graphRetransmissionDown.setData(errorsHistory);
+	graphRetransmissionUp.setData(errorsHistory);
+	graphErrorsDown.setData(errorsHistory);
+	graphErrorsUp.setData(errorsHistory);
+	graphErrorSecondsDown.setData(errorsHistory);
+	graphErrorSecondsUp.setData(errorsHistory);
+	graphFailureSecondsDown.setData(errorsHistory);
+	graphFailureSecondsUp.setData(errorsHistory);
+	graphFullInits.setData(errorsHistory);
+	graphRetrains.setData(errorsHistory);
+}
+
+function selectLine(event) {
+	var item = event.target.closest("[data-line]");
+	if (item) {
+		event.preventDefault();
+		selectedLine = parseInt(item.dataset.line);
+		updateLine();
+	}
+}
+
 function updateState(newState, info, data) {
 	let oldState = state;
 
@@ -52,6 +127,11 @@ function updateState(newState, info, data) {
 				fingerprint.innerText = info;
 				break;
 
+			case STATE_HOSTKEY:
+				hostKeyHost.innerText = info["host"];
+				hostKeyFingerprint.innerText = info["fingerprint"];
+				break;
+
 			case STATE_ERROR:
 				overlayError.innerText = info;
 				break;
@@ -60,20 +140,8 @@ function updateState(newState, info, data) {
 	}
 
 	if (data !== undefined) {
-		bins = DSLGraphs.decodeBins(data["bins"]);
-		binsHistory = DSLGraphs.decodeBinsHistory(data["bins_history"]);
-		var errorsHistory = DSLGraphs.decodeErrorsHistory(data["errors_history"]);
-		summary.innerHTML = data["summary"];
-		graphBits.setData(bins);
-		updateSNRGraph();
-		graphQLN.setData(bins);
-		graphHlog.setData(bins);
-		graphRetransmissionDown.setData(errorsHistory);
-		graphRetransmissionUp.setData(errorsHistory);
-		graphErrorsDown.setData(errorsHistory);
-		graphErrorsUp.setData(errorsHistory);
-		graphErrorSecondsDown.setData(errorsHistory);
-		graphErrorSecondsUp.setData(errorsHistory);
+		lastData = data;
+		updateLine();
 	}
 
 	if (newState != oldState) {
@@ -89,6 +157,7 @@ function updateState(newState, info, data) {
 		overlayPassword.classList.toggle("visible", state == STATE_PASSWORD);
 		overlayPassphrase.classList.toggle("visible", state == STATE_PASSPHRASE);
 		overlayEncryptionPassphrase.classList.toggle("visible", state == STATE_ENCRYPTION_PASSPHRASE);
+		overlayHostKey.classList.toggle("visible", state == STATE_HOSTKEY);
 		overlayError.classList.toggle("visible", state == STATE_ERROR);
 		overlayLoading.classList.toggle("visible", state == STATE_LOADING);
 
@@ -105,6 +174,9 @@ function updateState(newState, info, data) {
 function sendForm(event) {
 	let form = event.target;
 	let formData = new FormData(form);
+	if (event.submitter && event.submitter.name) {
+		formData.append(event.submitter.name, event.submitter.value);
+	}
 	form.reset();
 
 	updateState("loading");
@@ -202,6 +274,16 @@ function applyGraphParams(params) {
 
 	graphHlog.setParams(params);
 	graphHlogCanvas.style.width = width;
+
+	graphPSD.setParams(params);
+	graphPSDCanvas.style.width = width;
+
+	graphFEXTCancellation.setParams(params);
+	graphFEXTCancellationCanvas.style.width = width;
+	graphHlin.setParams(params);
+	graphHlinCanvas.style.width = width;
+	graphGains.setParams(params);
+	graphGainsCanvas.style.width = width;
 }
 
 function applyErrorsGraphParams(params) {
@@ -224,6 +306,18 @@ function applyErrorsGraphParams(params) {
 
 	graphErrorSecondsUp.setParams(params);
 	graphErrorSecondsUpCanvas.style.width = width;
+
+	graphFailureSecondsDown.setParams(params);
+	graphFailureSecondsDownCanvas.style.width = width;
+
+	graphFailureSecondsUp.setParams(params);
+	graphFailureSecondsUpCanvas.style.width = width;
+
+	graphFullInits.setParams(params);
+	graphFullInitsCanvas.style.width = width;
+
+	graphRetrains.setParams(params);
+	graphRetrainsCanvas.style.width = width;
 }
 
 function initGraphs() {
@@ -231,6 +325,13 @@ function initGraphs() {
 	graphSNRCanvas = document.getElementById("graph_snr");
 	graphQLNCanvas = document.getElementById("graph_qln");
 	graphHlogCanvas = document.getElementById("graph_hlog");
+	graphPSDCanvas = document.getElementById("graph_psd");
+	graphFEXTCancellationCanvas = document.getElementById("graph_fext");
+	graphFEXTCancellationSection = document.getElementById("graph_fext_section");
+	graphHlinCanvas = document.getElementById("graph_hlin");
+	graphHlinSection = document.getElementById("graph_hlin_section");
+	graphGainsCanvas = document.getElementById("graph_gains");
+	graphGainsSection = document.getElementById("graph_gains_section");
 
 	graphRetransmissionDownCanvas = document.getElementById("graph_retransmission_ds");
 	graphRetransmissionUpCanvas = document.getElementById("graph_retransmission_us");
@@ -238,6 +339,10 @@ function initGraphs() {
 	graphErrorsUpCanvas = document.getElementById("graph_errors_us");
 	graphErrorSecondsDownCanvas = document.getElementById("graph_errorseconds_ds");
 	graphErrorSecondsUpCanvas = document.getElementById("graph_errorseconds_us");
+	graphFailureSecondsDownCanvas = document.getElementById("graph_failureseconds_ds");
+	graphFailureSecondsUpCanvas = document.getElementById("graph_failureseconds_us");
+	graphFullInitsCanvas = document.getElementById("graph_fullinits");
+	graphRetrainsCanvas = document.getElementById("graph_retrains");
 
 	var defaultParams = new DSLGraphs.GraphParams();
 
@@ -245,6 +350,10 @@ function initGraphs() {
 	graphSNR = new DSLGraphs.SNRGraph(graphSNRCanvas, defaultParams);
 	graphQLN = new DSLGraphs.QLNGraph(graphQLNCanvas, defaultParams);
 	graphHlog = new DSLGraphs.HlogGraph(graphHlogCanvas, defaultParams);
+	graphPSD = new DSLGraphs.PSDGraph(graphPSDCanvas, defaultParams);
+	graphFEXTCancellation = new DSLGraphs.FEXTCancellationGraph(graphFEXTCancellationCanvas, defaultParams);
+	graphHlin = new DSLGraphs.HlinGraph(graphHlinCanvas, defaultParams);
+	graphGains = new DSLGraphs.GainsGraph(graphGainsCanvas, defaultParams);
 
 	graphRetransmissionDown = new DSLGraphs.DownstreamRetransmissionGraph(graphRetransmissionDownCanvas, defaultParams);
 	graphRetransmissionUp = new DSLGraphs.UpstreamRetransmissionGraph(graphRetransmissionUpCanvas, defaultParams);
@@ -252,6 +361,10 @@ function initGraphs() {
 	graphErrorsUp = new DSLGraphs.UpstreamErrorsGraph(graphErrorsUpCanvas, defaultParams);
 	graphErrorSecondsDown = new DSLGraphs.DownstreamErrorSecondsGraph(graphErrorSecondsDownCanvas, defaultParams);
 	graphErrorSecondsUp = new DSLGraphs.UpstreamErrorSecondsGraph(graphErrorSecondsUpCanvas, defaultParams);
+	graphFailureSecondsDown = new DSLGraphs.DownstreamFailureSecondsGraph(graphFailureSecondsDownCanvas, defaultParams);
+	graphFailureSecondsUp = new DSLGraphs.UpstreamFailureSecondsGraph(graphFailureSecondsUpCanvas, defaultParams);
+	graphFullInits = new DSLGraphs.FullInitsGraph(graphFullInitsCanvas, defaultParams);
+	graphRetrains = new DSLGraphs.RetrainsGraph(graphRetrainsCanvas, defaultParams);
 
 	var lastDevicePixelRatio = 0;
 	var lastWidth = 0;
@@ -295,6 +408,7 @@ function loaded(event) {
 	linkSave = document.getElementById("link-save");
 
 	summary = document.getElementById("summary");
+	summary.addEventListener("click", selectLine);
 	graphs = document.getElementById("graphs");
 	errors = document.getElementById("errors");
 
@@ -305,10 +419,13 @@ function loaded(event) {
 	overlayPassword = document.getElementById("overlay-password");
 	overlayPassphrase = document.getElementById("overlay-passphrase");
 	overlayEncryptionPassphrase = document.getElementById("overlay-encryption-passphrase");
+	overlayHostKey = document.getElementById("overlay-hostkey");
 	overlayError = document.getElementById("overlay-error");
 	overlayLoading = document.getElementById("overlay-loading");
 
 	fingerprint = document.getElementById("fingerprint");
+	hostKeyHost = document.getElementById("hostkey-host");
+	hostKeyFingerprint = document.getElementById("hostkey-fingerprint");
 	inputPassword = document.getElementById("password");
 	inputPassphrase = document.getElementById("passphrase");
 	inputEncryptionPassphrase = document.getElementById("encryption-passphrase");
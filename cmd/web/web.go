@@ -92,6 +92,7 @@ func start(clientConfig dsl.Config, stateDir string) (addr string, err error) {
 		http.HandleFunc("/password", handlePassword)
 		http.HandleFunc("/passphrase", handlePassphrase)
 		http.HandleFunc("/encryption-passphrase", handleEncryptionPassphrase)
+		http.HandleFunc("/hostkey", handleHostKey)
 	}
 
 	listener, err := net.Listen("tcp", config.ListenAddress)
@@ -185,7 +186,8 @@ func getStateMessage(change common.StateChange) (msg common.Message) {
 	case config.DisableInteractiveAuth &&
 		(change.State == common.StatePasswordRequired ||
 			change.State == common.StatePassphraseRequired ||
-			change.State == common.StateEncryptionPassphraseRequired):
+			change.State == common.StateEncryptionPassphraseRequired ||
+			change.State == common.StateHostKeyConfirmationRequired):
 
 		msg = common.GetStateMessage(change)
 		msg.State = string(common.StateError)
@@ -316,3 +318,20 @@ func handleEncryptionPassphrase(w http.ResponseWriter, req *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func handleHostKey(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "405 method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	trust := req.PostFormValue("data") == "trust"
+
+	err := c.ConfirmHostKey(trust)
+	if err != nil {
+		http.Error(w, "403 forbidden", http.StatusForbidden)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -28,13 +28,30 @@ func EncryptionPassphrase(passphrase string) EncryptionPassphraseCallback {
 	return func() (string, error) { return passphrase, nil }
 }
 
+// UnknownHostKeyCallback is called if the host key of a device is not contained in the known hosts.
+// The key is trusted if no error is returned. The line can be appended to a known hosts file.
+type UnknownHostKeyCallback func(host, fingerprint, knownHostsLine string) error
+
+type JumpHostConfig struct {
+	Host            string
+	User            string
+	AuthPassword    PasswordCallback
+	AuthPrivateKeys PrivateKeysCallback
+	AuthSSHAgent    bool
+	KnownHosts      string
+	UnknownHostKey  UnknownHostKeyCallback
+}
+
 type Config struct {
 	Type                 ClientType
 	Host                 string
 	User                 string
 	AuthPassword         PasswordCallback
 	AuthPrivateKeys      PrivateKeysCallback
+	AuthSSHAgent         bool
 	EncryptionPassphrase EncryptionPassphraseCallback
 	KnownHosts           string
+	UnknownHostKey       UnknownHostKeyCallback
+	JumpHost             *JumpHostConfig
 	Options              map[string]string
 }
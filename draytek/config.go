@@ -9,7 +9,13 @@ import (
 )
 
 type TelnetConfig struct {
-	Host     string
-	User     string
-	Password dsl.PasswordCallback
+	Host           string
+	User           string
+	Password       dsl.PasswordCallback
+	Serial         bool
+	BaudRate       int
+	AccountPrompt  string
+	PasswordPrompt string
+	CommandPrompt  string
+	PreCommands    []string
 }
@@ -6,14 +6,21 @@ package draytek
 
 import (
 	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/telnet"
 )
 
 func init() {
 	newTelnet := func(config dsl.Config) (dsl.Client, error) {
 		telnetConfig := TelnetConfig{
-			Host:     config.Host,
-			User:     config.User,
-			Password: config.AuthPassword,
+			Host:           config.Host,
+			User:           config.User,
+			Password:       config.AuthPassword,
+			Serial:         config.Options["Serial"] == "1",
+			BaudRate:       telnet.ParseBaudRate(config.Options["BaudRate"]),
+			AccountPrompt:  config.Options["AccountPrompt"],
+			PasswordPrompt: config.Options["PasswordPrompt"],
+			CommandPrompt:  config.Options["CommandPrompt"],
+			PreCommands:    telnet.ParsePreCommands(config.Options["PreCommands"]),
 		}
 		return NewTelnetClient(telnetConfig)
 	}
@@ -21,6 +28,14 @@ func init() {
 		Title:              "DrayTek (Telnet)",
 		RequiresUser:       dsl.TristateMaybe,
 		SupportedAuthTypes: dsl.AuthTypePassword,
+		Options: map[string]dsl.Option{
+			"Serial":         telnet.GetSerialOption(),
+			"BaudRate":       telnet.GetBaudRateOption(),
+			"AccountPrompt":  telnet.GetAccountPromptOption(),
+			"PasswordPrompt": telnet.GetPasswordPromptOption(),
+			"CommandPrompt":  telnet.GetCommandPromptOption(),
+			"PreCommands":    telnet.GetPreCommandsOption(),
+		},
 	}
 	dsl.RegisterClient("draytek_telnet", newTelnet, clientDescTelnet)
 }
@@ -358,6 +358,9 @@ func interpretNearFarInterleavingDelay(values map[string][2]string) (near, far m
 func interpretCounts(status *models.Status, values map[string][2]string) {
 	status.DownstreamFECCount, status.UpstreamFECCount = interpretNearFarIntValue(values, "FEC")
 	status.DownstreamSESCount, status.UpstreamSESCount = interpretNearFarIntValue(values, "SES")
+	status.DownstreamLOSSCount, status.UpstreamLOSSCount = interpretNearFarIntValue(values, "LOS")
+	status.DownstreamLOFSCount, status.UpstreamLOFSCount = interpretNearFarIntValue(values, "LOF")
+	status.DownstreamUASCount, status.UpstreamUASCount = interpretNearFarIntValue(values, "UAS")
 }
 
 func interpretMore(status *models.Status, values map[string][2]string) {
@@ -36,7 +36,13 @@ func NewTelnetClient(config TelnetConfig) (dsl.Client, error) {
 			},
 		},
 		ExpectRepeatedPromptCRLF: true,
+		Serial:                   config.Serial,
+		BaudRate:                 config.BaudRate,
+		PreCommands:              config.PreCommands,
 	}
+	clientConfig.Prompts = telnet.WithCustomPrompts(clientConfig.Prompts,
+		config.AccountPrompt, config.PasswordPrompt, config.CommandPrompt)
+
 	c.client, err = telnet.NewClient(clientConfig, config.Host, user, config.Password)
 	if err != nil {
 		return nil, err
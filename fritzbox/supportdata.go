@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"3e8.eu/go/dsl/internal/helpers"
 	"3e8.eu/go/dsl/models"
 )
 
@@ -54,6 +55,12 @@ func parseSupportData(status *models.Status, bins *models.Bins, d *rawDataSuppor
 	parseSupportDataIntValue(&status.DownstreamSESCount, values, "DS SES")
 	parseSupportDataIntValue(&status.UpstreamSESCount, values, "US SES")
 
+	parseSupportDataBoolValue(&status.Configuration.DownstreamTrellis, values, "DS Trellis")
+	parseSupportDataBoolValue(&status.Configuration.UpstreamTrellis, values, "US Trellis")
+
+	parseSupportDataFraming(&status.Configuration.DownstreamFraming, values, "DS")
+	parseSupportDataFraming(&status.Configuration.UpstreamFraming, values, "US")
+
 	batGroupSizeStr, _ := getSupportDataItem(values, "BAT Bins per Group", "BAT Bins P/Group")
 	batGroupSize, _ := strconv.Atoi(batGroupSizeStr)
 
@@ -68,6 +75,11 @@ func parseSupportData(status *models.Status, bins *models.Bins, d *rawDataSuppor
 	bins.QLN.Downstream = parseSupportDataBins(bins.Bands.Downstream, values, "QLN DS Array", "QLN Array")
 	bins.QLN.Upstream = parseSupportDataBins(bins.Bands.Upstream, values, "QLN US Array")
 
+	if bins.Mode.Type == models.ModeTypeVDSL2 {
+		status.DownstreamBands = parseSupportDataBandStatus(bins.Bands, false, values, "DS")
+		status.UpstreamBands = parseSupportDataBandStatus(bins.Bands, true, values, "US")
+	}
+
 	if bins.Mode.Type == models.ModeTypeVDSL2 && bins.Mode.Subtype == models.ModeSubtypeProfile35b {
 		// It seems like the AVM firmware applies some scaling to the upstream data for VDSL2 Profile 35b,
 		// so adjust the guessed group size to take this into account.
@@ -131,6 +143,16 @@ func parseSupportDataOLRValue(out *models.OLRValue, values map[string]string, ke
 	parseSupportDataIntValue(&out.Executed, values, key+" Cnt")
 }
 
+func parseSupportDataFraming(out *models.Framing, values map[string]string, prefix string) {
+	parseSupportDataIntValue(&out.L, values, prefix+" L")
+	parseSupportDataIntValue(&out.D, values, prefix+" D")
+	parseSupportDataIntValue(&out.N, values, prefix+" N")
+	parseSupportDataIntValue(&out.R, values, prefix+" R")
+	parseSupportDataIntValue(&out.I, values, prefix+" I")
+	parseSupportDataIntValue(&out.M, values, prefix+" M")
+	parseSupportDataIntValue(&out.T, values, prefix+" T")
+}
+
 func parseSupportDataPilotTones(pilotTones *[]int, groupSize int, values map[string]string, keys ...string) {
 	val, ok := getSupportDataItem(values, keys...)
 	if !ok {
@@ -180,6 +202,60 @@ func parseSupportDataBands(bands *[]models.Band, mode models.Mode, groupSize int
 	}
 }
 
+func parseSupportDataBandValues(values map[string]string, keys ...string) []models.FloatValue {
+	val, ok := getSupportDataItem(values, keys...)
+	if !ok {
+		return nil
+	}
+
+	data := strings.Split(val, ",")
+	out := make([]models.FloatValue, len(data))
+
+	for i, item := range data {
+		// values are reported in 0.1 dB, special values indicate that no data is available
+		valInt, err := strconv.ParseInt(strings.TrimSpace(item), 10, 64)
+		if err == nil && valInt > -512 && valInt < 1271 {
+			out[i].Float = float64(valInt) / 10
+			out[i].Valid = true
+		}
+	}
+
+	return out
+}
+
+func parseSupportDataBandStatus(bands models.BandsDownUp, upstream bool, values map[string]string, prefix string) (out []models.BandStatus) {
+	latn := parseSupportDataBandValues(values, prefix+" LATN")
+	satn := parseSupportDataBandValues(values, prefix+" SATN")
+	snrm := parseSupportDataBandValues(values, prefix+" SNRM")
+
+	count := len(latn)
+	if len(satn) > count {
+		count = len(satn)
+	}
+	if len(snrm) > count {
+		count = len(snrm)
+	}
+
+	for i := 0; i < count; i++ {
+		band := models.BandStatus{Name: helpers.BandName(bands, upstream, i)}
+		if i < len(latn) {
+			band.Attenuation.FloatValue = latn[i]
+		}
+		if i < len(satn) {
+			band.SignalAttenuation.FloatValue = satn[i]
+		}
+		if i < len(snrm) {
+			band.SNRMargin.FloatValue = snrm[i]
+		}
+
+		if band.IsValid() {
+			out = append(out, band)
+		}
+	}
+
+	return
+}
+
 func calculateSupportDataGroupSize(lastBandsIndex int) int {
 	groupSize := 1
 	for 512*groupSize < lastBandsIndex+1 {
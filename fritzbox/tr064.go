@@ -5,46 +5,18 @@
 package fritzbox
 
 import (
-	"encoding/xml"
 	"strings"
 
+	"3e8.eu/go/dsl/internal/tr064"
 	"3e8.eu/go/dsl/models"
 )
 
-type interfaceConfigInfoType struct {
-	XMLName xml.Name `xml:"Envelope"`
-	Data    struct {
-		NewUpstreamPower   int64 `xml:"NewUpstreamPower"`
-		NewDownstreamPower int64 `xml:"NewDownstreamPower"`
-	} `xml:"Body>GetInfoResponse"`
-}
-
-type interfaceConfigStatisticsTotalType struct {
-	XMLName xml.Name `xml:"Envelope"`
-	Data    struct {
-		NewErroredSecs         int64 `xml:"NewErroredSecs"`
-		NewSeverelyErroredSecs int64 `xml:"NewSeverelyErroredSecs"`
-		NewFECErrors           int64 `xml:"NewFECErrors"`
-		NewATUCFECErrors       int64 `xml:"NewATUCFECErrors"`
-		NewCRCErrors           int64 `xml:"NewCRCErrors"`
-		NewATUCCRCErrors       int64 `xml:"NewATUCCRCErrors"`
-	} `xml:"Body>GetStatisticsTotalResponse"`
-}
-
 func parseTR064Data(status *models.Status, d *rawDataTR064) {
-	var info interfaceConfigInfoType
-	err := xml.Unmarshal([]byte(d.InterfaceConfigInfo), &info)
+	info, err := tr064.ParseInterfaceConfigInfo(d.InterfaceConfigInfo)
 
 	if err == nil {
-		if info.Data.NewUpstreamPower != 0 {
-			status.UpstreamPower.FloatValue.Float = float64(info.Data.NewUpstreamPower) - 500
-		}
-		status.UpstreamPower.FloatValue.Valid = true
-
-		if info.Data.NewDownstreamPower != 0 {
-			status.DownstreamPower.FloatValue.Float = float64(info.Data.NewDownstreamPower) - 500
-		}
-		status.DownstreamPower.FloatValue.Valid = true
+		status.UpstreamPower.FloatValue = tr064.InterpretPower(info.UpstreamPower)
+		status.DownstreamPower.FloatValue = tr064.InterpretPower(info.DownstreamPower)
 
 		// This applies for the UR8-based 7270v3, but maybe other devices need it as well
 		if strings.HasPrefix(status.NearEndInventory.Version, "1.52.") {
@@ -60,30 +32,31 @@ func parseTR064Data(status *models.Status, d *rawDataTR064) {
 		}
 	}
 
-	var statistics interfaceConfigStatisticsTotalType
-	err = xml.Unmarshal([]byte(d.InterfaceConfigStatisticsTotal), &statistics)
+	statistics, err := tr064.ParseInterfaceConfigStatisticsTotal(d.InterfaceConfigStatisticsTotal)
 
 	if err == nil {
+		// the seconds counters from the support data are preferred if available
+		var counters models.Status
+		tr064.InterpretStatistics(&counters, statistics)
+
 		if !status.DownstreamESCount.Valid {
-			status.DownstreamESCount.Int = statistics.Data.NewErroredSecs
-			status.DownstreamESCount.Valid = true
+			status.DownstreamESCount = counters.DownstreamESCount
+		}
+		if !status.UpstreamESCount.Valid {
+			status.UpstreamESCount = counters.UpstreamESCount
 		}
 
 		if !status.DownstreamSESCount.Valid {
-			status.DownstreamSESCount.Int = statistics.Data.NewSeverelyErroredSecs
-			status.DownstreamSESCount.Valid = true
+			status.DownstreamSESCount = counters.DownstreamSESCount
+		}
+		if !status.UpstreamSESCount.Valid {
+			status.UpstreamSESCount = counters.UpstreamSESCount
 		}
 
-		status.DownstreamFECCount.Int = statistics.Data.NewFECErrors
-		status.DownstreamFECCount.Valid = true
-
-		status.UpstreamFECCount.Int = statistics.Data.NewATUCFECErrors
-		status.UpstreamFECCount.Valid = true
-
-		status.DownstreamCRCCount.Int = statistics.Data.NewCRCErrors
-		status.DownstreamCRCCount.Valid = true
+		status.DownstreamFECCount = counters.DownstreamFECCount
+		status.UpstreamFECCount = counters.UpstreamFECCount
 
-		status.UpstreamCRCCount.Int = statistics.Data.NewATUCCRCErrors
-		status.UpstreamCRCCount.Valid = true
+		status.DownstreamCRCCount = counters.DownstreamCRCCount
+		status.UpstreamCRCCount = counters.UpstreamCRCCount
 	}
 }
@@ -11,7 +11,6 @@ require (
 	github.com/ziutek/telnet v0.0.0-20180329124119-c3b780dc415b
 	golang.org/x/crypto v0.17.0
 	golang.org/x/net v0.19.0
+	golang.org/x/sys v0.15.0
 	golang.org/x/term v0.15.0
 )
-
-require golang.org/x/sys v0.15.0 // indirect
@@ -751,3 +751,355 @@ func DrawHlogGraph(out io.Writer, data models.Bins, params GraphParams) error {
 
 	return writeTemplate(out, m, templateBase, templateHlog)
 }
+
+func GetHlinGraphLegend() Legend {
+	return Legend{
+		Title: "Channel characteristic Hlin (dB)",
+	}
+}
+
+func DrawHlinGraph(out io.Writer, data models.Bins, params GraphParams) error {
+	bins, freq := getLegendX(data.Mode)
+
+	params.normalize()
+
+	hlinDownstream := data.Hlin.Downstream.Decibel()
+	hlinUpstream := data.Hlin.Upstream.Decibel()
+
+	bottom := -100.0
+	top := 7.0
+
+	if params.PreferDynamicAxisLimits {
+		min, max, valid := determineBinsFloatAxisLimits(-96.2, 6, 20, false,
+			hlinDownstream.Data,
+			hlinUpstream.Data)
+
+		if valid {
+			bottom = min
+			top = max
+		}
+	}
+
+	spec := graphSpec{
+		Width:                  params.Width,
+		Height:                 params.Height,
+		ScaleFactor:            params.ScaleFactor,
+		FontSize:               params.FontSize,
+		ColorBackground:        params.ColorBackground,
+		ColorForeground:        params.ColorForeground,
+		LegendXMin:             0,
+		LegendXMax:             freq,
+		LegendXLabelStart:      0,
+		LegendXLabelEnd:        int(freq),
+		LegendXLabelSteps:      []int{50, 100, 200, 500, 1000, 1250, 2500, 5000, 10000},
+		LegendXLabelFormatFunc: formatLegendXLabelBinsFreq,
+		LegendXLabelDigits:     4.0,
+		LegendYBottom:          bottom,
+		LegendYTop:             top,
+		LegendYLabelStart:      int(math.Ceil(bottom)),
+		LegendYLabelEnd:        int(math.Floor(top)),
+		LegendYLabelSteps:      []int{1, 2, 5, 10, 20},
+		LegendYLabelFormatFunc: formatLegendYLabelBins,
+		LegendYLabelDigits:     3.75,
+		LegendEnabled:          params.Legend,
+		LegendData:             GetHlinGraphLegend(),
+	}
+
+	m := hlinModel{}
+	m.baseModel = getBaseModel(spec)
+
+	x := m.GraphX
+	y := m.GraphY
+	w := m.GraphWidth
+	h := m.GraphHeight
+
+	scaleX := w / float64(bins)
+	scaleY := h / (spec.LegendYTop - spec.LegendYBottom)
+
+	setBandsData(&m.baseModel, data, true)
+
+	m.Path.SetPrecision(1)
+
+	buildHlogPath(&m.Path, hlinDownstream, scaleY, spec.LegendYBottom, spec.LegendYTop, 1/scaleX)
+	buildHlogPath(&m.Path, hlinUpstream, scaleY, spec.LegendYBottom, spec.LegendYTop, 1/scaleX)
+
+	// scaling of y by scaleX in order to simulate vector-effect="non-scaling-stroke" for non-supporting renderers
+	m.Transform.Translate(x, y+h)
+	m.Transform.Scale(scaleX, -scaleX)
+
+	m.StrokeWidth = spec.ScaleFactor / scaleX
+
+	return writeTemplate(out, m, templateBase, templateHlin)
+}
+
+func buildLinePath(p *path, bins models.BinsFloat, scaleY, offsetY, maxY, postScaleY, minYValid, maxYValid float64) {
+	width := float64(bins.GroupSize)
+
+	var lastValid, lastDrawn bool
+	var last float64
+	var lastPosY float64
+
+	count := len(bins.Data)
+	for i := 0; i < count; i++ {
+		val := bins.Data[i]
+		valid := val >= minYValid && val <= maxYValid
+		changed := last != val
+		drawn := false
+
+		posX := (float64(i) + 0.5) * width
+		posY := math.Max(0, math.Min(maxY, val)-offsetY)*scaleY - 0.5
+
+		if lastValid && !valid {
+			p.LineTo(posX-0.5*width, lastPosY*postScaleY)
+		}
+		if !lastValid && valid {
+			p.MoveTo(posX-0.5*width, posY*postScaleY)
+			lastPosY = posY
+		}
+		if valid && changed {
+			if lastValid {
+				if !lastDrawn {
+					p.LineTo(posX-width, lastPosY*postScaleY)
+				}
+				p.LineTo(posX, posY*postScaleY)
+				drawn = true
+			}
+			lastPosY = posY
+		}
+
+		lastDrawn = drawn
+		lastValid = valid
+		last = val
+	}
+
+	if lastValid {
+		p.LineTo(float64(count*bins.GroupSize), lastPosY*postScaleY)
+	}
+}
+
+func GetPSDGraphLegend() Legend {
+	return Legend{
+		Title: "Transmit PSD mask (dBm/Hz)",
+	}
+}
+
+func DrawPSDGraph(out io.Writer, data models.Bins, params GraphParams) error {
+	bins, freq := getLegendX(data.Mode)
+
+	params.normalize()
+
+	bottom := -100.0
+	top := -30.0
+
+	if params.PreferDynamicAxisLimits {
+		min, max, valid := determineBinsFloatAxisLimits(-150, -20, 20, false,
+			data.PSD.Downstream.Data,
+			data.PSD.Upstream.Data)
+
+		if valid {
+			bottom = min
+			top = max
+		}
+	}
+
+	spec := graphSpec{
+		Width:                  params.Width,
+		Height:                 params.Height,
+		ScaleFactor:            params.ScaleFactor,
+		FontSize:               params.FontSize,
+		ColorBackground:        params.ColorBackground,
+		ColorForeground:        params.ColorForeground,
+		LegendXMin:             0,
+		LegendXMax:             freq,
+		LegendXLabelStart:      0,
+		LegendXLabelEnd:        int(freq),
+		LegendXLabelSteps:      []int{50, 100, 200, 500, 1000, 1250, 2500, 5000, 10000},
+		LegendXLabelFormatFunc: formatLegendXLabelBinsFreq,
+		LegendXLabelDigits:     4.0,
+		LegendYBottom:          bottom,
+		LegendYTop:             top,
+		LegendYLabelStart:      int(math.Ceil(bottom)),
+		LegendYLabelEnd:        int(math.Floor(top)),
+		LegendYLabelSteps:      []int{1, 2, 5, 10, 20},
+		LegendYLabelFormatFunc: formatLegendYLabelBins,
+		LegendYLabelDigits:     3.75,
+		LegendEnabled:          params.Legend,
+		LegendData:             GetPSDGraphLegend(),
+	}
+
+	m := psdModel{}
+	m.baseModel = getBaseModel(spec)
+
+	x := m.GraphX
+	y := m.GraphY
+	w := m.GraphWidth
+	h := m.GraphHeight
+
+	scaleX := w / float64(bins)
+	scaleY := h / (spec.LegendYTop - spec.LegendYBottom)
+
+	setBandsData(&m.baseModel, data, true)
+
+	m.Path.SetPrecision(1)
+
+	buildLinePath(&m.Path, data.PSD.Downstream, scaleY, spec.LegendYBottom, spec.LegendYTop, 1/scaleX, -150, -20)
+	buildLinePath(&m.Path, data.PSD.Upstream, scaleY, spec.LegendYBottom, spec.LegendYTop, 1/scaleX, -150, -20)
+
+	// scaling of y by scaleX in order to simulate vector-effect="non-scaling-stroke" for non-supporting renderers
+	m.Transform.Translate(x, y+h)
+	m.Transform.Scale(scaleX, -scaleX)
+
+	m.StrokeWidth = spec.ScaleFactor / scaleX
+
+	return writeTemplate(out, m, templateBase, templatePSD)
+}
+
+func GetFEXTCancellationGraphLegend() Legend {
+	return Legend{
+		Title: "FEXT cancellation gain (dB)",
+	}
+}
+
+func DrawFEXTCancellationGraph(out io.Writer, data models.Bins, params GraphParams) error {
+	bins, freq := getLegendX(data.Mode)
+
+	params.normalize()
+
+	bottom := 0.0
+	top := 50.0
+
+	if params.PreferDynamicAxisLimits {
+		_, max, valid := determineBinsFloatAxisLimits(0, 100, 20, true,
+			data.FEXTCancellation.Downstream.Data,
+			data.FEXTCancellation.Upstream.Data)
+
+		if valid {
+			top = max
+		}
+	}
+
+	spec := graphSpec{
+		Width:                  params.Width,
+		Height:                 params.Height,
+		ScaleFactor:            params.ScaleFactor,
+		FontSize:               params.FontSize,
+		ColorBackground:        params.ColorBackground,
+		ColorForeground:        params.ColorForeground,
+		LegendXMin:             0,
+		LegendXMax:             freq,
+		LegendXLabelStart:      0,
+		LegendXLabelEnd:        int(freq),
+		LegendXLabelSteps:      []int{50, 100, 200, 500, 1000, 1250, 2500, 5000, 10000},
+		LegendXLabelFormatFunc: formatLegendXLabelBinsFreq,
+		LegendXLabelDigits:     4.0,
+		LegendYBottom:          bottom,
+		LegendYTop:             top,
+		LegendYLabelStart:      int(math.Ceil(bottom)),
+		LegendYLabelEnd:        int(math.Floor(top)),
+		LegendYLabelSteps:      []int{1, 2, 5, 10},
+		LegendYLabelFormatFunc: formatLegendYLabelBins,
+		LegendYLabelDigits:     3.75,
+		LegendEnabled:          params.Legend,
+		LegendData:             GetFEXTCancellationGraphLegend(),
+	}
+
+	m := fextCancellationModel{}
+	m.baseModel = getBaseModel(spec)
+
+	x := m.GraphX
+	y := m.GraphY
+	w := m.GraphWidth
+	h := m.GraphHeight
+
+	scaleX := w / float64(bins)
+	scaleY := h / (spec.LegendYTop - spec.LegendYBottom)
+
+	setBandsData(&m.baseModel, data, true)
+
+	m.Path.SetPrecision(1)
+
+	buildSNRQLNPath(&m.Path, data.FEXTCancellation.Downstream, scaleY, spec.LegendYBottom, spec.LegendYTop, 0, 100)
+	buildSNRQLNPath(&m.Path, data.FEXTCancellation.Upstream, scaleY, spec.LegendYBottom, spec.LegendYTop, 0, 100)
+
+	m.Transform.Translate(x, y+h)
+	m.Transform.Scale(scaleX, -1)
+
+	return writeTemplate(out, m, templateBase, templateFEXTCancellation)
+}
+
+func GetGainsGraphLegend() Legend {
+	return Legend{
+		Title: "Fine gains (dB)",
+	}
+}
+
+func DrawGainsGraph(out io.Writer, data models.Bins, params GraphParams) error {
+	bins, freq := getLegendX(data.Mode)
+
+	params.normalize()
+
+	bottom := -15.0
+	top := 3.0
+
+	if params.PreferDynamicAxisLimits {
+		min, max, valid := determineBinsFloatAxisLimits(-14.5, 2.5, 5, false,
+			data.Gains.Downstream.Data,
+			data.Gains.Upstream.Data)
+
+		if valid {
+			bottom = min
+			top = max
+		}
+	}
+
+	spec := graphSpec{
+		Width:                  params.Width,
+		Height:                 params.Height,
+		ScaleFactor:            params.ScaleFactor,
+		FontSize:               params.FontSize,
+		ColorBackground:        params.ColorBackground,
+		ColorForeground:        params.ColorForeground,
+		LegendXMin:             0,
+		LegendXMax:             freq,
+		LegendXLabelStart:      0,
+		LegendXLabelEnd:        int(freq),
+		LegendXLabelSteps:      []int{50, 100, 200, 500, 1000, 1250, 2500, 5000, 10000},
+		LegendXLabelFormatFunc: formatLegendXLabelBinsFreq,
+		LegendXLabelDigits:     4.0,
+		LegendYBottom:          bottom,
+		LegendYTop:             top,
+		LegendYLabelStart:      int(math.Ceil(bottom)),
+		LegendYLabelEnd:        int(math.Floor(top)),
+		LegendYLabelSteps:      []int{1, 2, 5, 10},
+		LegendYLabelFormatFunc: formatLegendYLabelBins,
+		LegendYLabelDigits:     3.75,
+		LegendEnabled:          params.Legend,
+		LegendData:             GetGainsGraphLegend(),
+	}
+
+	m := gainsModel{}
+	m.baseModel = getBaseModel(spec)
+
+	x := m.GraphX
+	y := m.GraphY
+	w := m.GraphWidth
+	h := m.GraphHeight
+
+	scaleX := w / float64(bins)
+	scaleY := h / (spec.LegendYTop - spec.LegendYBottom)
+
+	setBandsData(&m.baseModel, data, true)
+
+	m.Path.SetPrecision(1)
+
+	buildLinePath(&m.Path, data.Gains.Downstream, scaleY, spec.LegendYBottom, spec.LegendYTop, 1/scaleX, -14.5, 2.5)
+	buildLinePath(&m.Path, data.Gains.Upstream, scaleY, spec.LegendYBottom, spec.LegendYTop, 1/scaleX, -14.5, 2.5)
+
+	// scaling of y by scaleX in order to simulate vector-effect="non-scaling-stroke" for non-supporting renderers
+	m.Transform.Translate(x, y+h)
+	m.Transform.Scale(scaleX, -scaleX)
+
+	m.StrokeWidth = spec.ScaleFactor / scaleX
+
+	return writeTemplate(out, m, templateBase, templateGains)
+}
@@ -403,3 +403,87 @@ func DrawUpstreamErrorSecondsGraph(out io.Writer, data models.ErrorsHistory, par
 			{data: data.UpstreamSESCount, color: colorRed},
 		})
 }
+
+func GetDownstreamFailureSecondsGraphLegend() Legend {
+	return Legend{
+		Title: "Downstream failure seconds",
+		Items: []LegendItem{
+			{Color: colorGreen, Text: "Unavailable (UAS)"},
+			{Color: colorBlue, Text: "Loss of signal (LOSS)"},
+			{Color: colorRed, Text: "Loss of frame (LOFS)"},
+		},
+	}
+}
+
+func DrawDownstreamFailureSecondsGraph(out io.Writer, data models.ErrorsHistory, params GraphParams) error {
+	return drawErrorsGraph(out, data, params,
+		GetDownstreamFailureSecondsGraphLegend(),
+		[]errorsGraphItem{
+			{data: data.DownstreamUASCount, color: colorGreen},
+			{data: data.DownstreamLOSSCount, color: colorBlue},
+			{data: data.DownstreamLOFSCount, color: colorRed},
+		})
+}
+
+func GetUpstreamFailureSecondsGraphLegend() Legend {
+	return Legend{
+		Title: "Upstream failure seconds",
+		Items: []LegendItem{
+			{Color: colorGreen, Text: "Unavailable (UAS)"},
+			{Color: colorBlue, Text: "Loss of signal (LOSS)"},
+			{Color: colorRed, Text: "Loss of frame (LOFS)"},
+		},
+	}
+}
+
+func DrawUpstreamFailureSecondsGraph(out io.Writer, data models.ErrorsHistory, params GraphParams) error {
+	return drawErrorsGraph(out, data, params,
+		GetUpstreamFailureSecondsGraphLegend(),
+		[]errorsGraphItem{
+			{data: data.UpstreamUASCount, color: colorGreen},
+			{data: data.UpstreamLOSSCount, color: colorBlue},
+			{data: data.UpstreamLOFSCount, color: colorRed},
+		})
+}
+
+func GetFullInitsGraphLegend() Legend {
+	return Legend{
+		Title: "Full initializations",
+		Items: []LegendItem{
+			{Color: colorGreen, Text: "Successful"},
+			{Color: colorBlue, Text: "Failed"},
+			{Color: colorRed, Text: "Loss of link (LOL)"},
+		},
+	}
+}
+
+func DrawFullInitsGraph(out io.Writer, data models.ErrorsHistory, params GraphParams) error {
+	return drawErrorsGraph(out, data, params,
+		GetFullInitsGraphLegend(),
+		[]errorsGraphItem{
+			{data: data.FullInitCount, color: colorGreen},
+			{data: data.FailedFullInitCount, color: colorBlue},
+			{data: data.LinkLossCount, color: colorRed},
+		})
+}
+
+func GetRetrainsGraphLegend() Legend {
+	return Legend{
+		Title: "Retrains",
+		Items: []LegendItem{
+			{Color: colorGreen, Text: "Successful"},
+			{Color: colorBlue, Text: "Failed"},
+			{Color: colorRed, Text: "Loss of link (LOL)"},
+		},
+	}
+}
+
+func DrawRetrainsGraph(out io.Writer, data models.ErrorsHistory, params GraphParams) error {
+	return drawErrorsGraph(out, data, params,
+		GetRetrainsGraphLegend(),
+		[]errorsGraphItem{
+			{data: data.RetrainCount, color: colorGreen},
+			{data: data.FailedRetrainCount, color: colorBlue},
+			{data: data.LinkLossCount, color: colorRed},
+		})
+}
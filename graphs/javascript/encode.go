@@ -25,6 +25,10 @@ func EncodeBins(bins models.Bins) json.RawMessage {
 		bins.PilotTones = make([]int, 0, 0)
 	}
 
+	// Hlin is only used for drawing the magnitude, so the conversion is done here
+	hlinDownstream := bins.Hlin.Downstream.Decibel()
+	hlinUpstream := bins.Hlin.Upstream.Decibel()
+
 	binsMap := map[string]interface{}{
 		"BinCount":       bins.Mode.BinCount(),
 		"CarrierSpacing": bins.Mode.CarrierSpacing(),
@@ -68,6 +72,46 @@ func EncodeBins(bins models.Bins) json.RawMessage {
 				"Data":      encodeListFloat64(bins.Hlog.Upstream.Data),
 			},
 		},
+		"PSD": map[string]interface{}{
+			"Downstream": map[string]interface{}{
+				"GroupSize": bins.PSD.Downstream.GroupSize,
+				"Data":      encodeListFloat64(bins.PSD.Downstream.Data),
+			},
+			"Upstream": map[string]interface{}{
+				"GroupSize": bins.PSD.Upstream.GroupSize,
+				"Data":      encodeListFloat64(bins.PSD.Upstream.Data),
+			},
+		},
+		"Hlin": map[string]interface{}{
+			"Downstream": map[string]interface{}{
+				"GroupSize": hlinDownstream.GroupSize,
+				"Data":      encodeListFloat64(hlinDownstream.Data),
+			},
+			"Upstream": map[string]interface{}{
+				"GroupSize": hlinUpstream.GroupSize,
+				"Data":      encodeListFloat64(hlinUpstream.Data),
+			},
+		},
+		"Gains": map[string]interface{}{
+			"Downstream": map[string]interface{}{
+				"GroupSize": bins.Gains.Downstream.GroupSize,
+				"Data":      encodeListFloat64(bins.Gains.Downstream.Data),
+			},
+			"Upstream": map[string]interface{}{
+				"GroupSize": bins.Gains.Upstream.GroupSize,
+				"Data":      encodeListFloat64(bins.Gains.Upstream.Data),
+			},
+		},
+		"FEXTCancellation": map[string]interface{}{
+			"Downstream": map[string]interface{}{
+				"GroupSize": bins.FEXTCancellation.Downstream.GroupSize,
+				"Data":      encodeListFloat64(bins.FEXTCancellation.Downstream.Data),
+			},
+			"Upstream": map[string]interface{}{
+				"GroupSize": bins.FEXTCancellation.Upstream.GroupSize,
+				"Data":      encodeListFloat64(bins.FEXTCancellation.Upstream.Data),
+			},
+		},
 	}
 
 	data, _ := json.Marshal(binsMap)
@@ -117,6 +161,17 @@ func EncodeErrorsHistory(errorsHistory models.ErrorsHistory) json.RawMessage {
 		"UpstreamESCount":      encodeListIntValue(errorsHistory.UpstreamESCount),
 		"DownstreamSESCount":   encodeListIntValue(errorsHistory.DownstreamSESCount),
 		"UpstreamSESCount":     encodeListIntValue(errorsHistory.UpstreamSESCount),
+		"DownstreamLOSSCount":  encodeListIntValue(errorsHistory.DownstreamLOSSCount),
+		"UpstreamLOSSCount":    encodeListIntValue(errorsHistory.UpstreamLOSSCount),
+		"DownstreamLOFSCount":  encodeListIntValue(errorsHistory.DownstreamLOFSCount),
+		"UpstreamLOFSCount":    encodeListIntValue(errorsHistory.UpstreamLOFSCount),
+		"DownstreamUASCount":   encodeListIntValue(errorsHistory.DownstreamUASCount),
+		"UpstreamUASCount":     encodeListIntValue(errorsHistory.UpstreamUASCount),
+		"FullInitCount":        encodeListIntValue(errorsHistory.FullInitCount),
+		"FailedFullInitCount":  encodeListIntValue(errorsHistory.FailedFullInitCount),
+		"RetrainCount":         encodeListIntValue(errorsHistory.RetrainCount),
+		"FailedRetrainCount":   encodeListIntValue(errorsHistory.FailedRetrainCount),
+		"LinkLossCount":        encodeListIntValue(errorsHistory.LinkLossCount),
 	}
 
 	data, _ := json.Marshal(historyMap)
@@ -238,6 +238,14 @@ var DSLGraphs = DSLGraphs || (function () {
 		data.QLN.Upstream.Data = decodeList(data.QLN.Upstream.Data);
 		data.Hlog.Downstream.Data = decodeList(data.Hlog.Downstream.Data);
 		data.Hlog.Upstream.Data = decodeList(data.Hlog.Upstream.Data);
+		data.PSD.Downstream.Data = decodeList(data.PSD.Downstream.Data);
+		data.PSD.Upstream.Data = decodeList(data.PSD.Upstream.Data);
+		data.FEXTCancellation.Downstream.Data = decodeList(data.FEXTCancellation.Downstream.Data);
+		data.FEXTCancellation.Upstream.Data = decodeList(data.FEXTCancellation.Upstream.Data);
+		data.Hlin.Downstream.Data = decodeList(data.Hlin.Downstream.Data);
+		data.Hlin.Upstream.Data = decodeList(data.Hlin.Upstream.Data);
+		data.Gains.Downstream.Data = decodeList(data.Gains.Downstream.Data);
+		data.Gains.Upstream.Data = decodeList(data.Gains.Upstream.Data);
 		return data;
 	}
 
@@ -267,6 +275,17 @@ var DSLGraphs = DSLGraphs || (function () {
 		data.UpstreamESCount = decodeList(data.UpstreamESCount);
 		data.DownstreamSESCount = decodeList(data.DownstreamSESCount);
 		data.UpstreamSESCount = decodeList(data.UpstreamSESCount);
+		data.DownstreamLOSSCount = decodeList(data.DownstreamLOSSCount);
+		data.UpstreamLOSSCount = decodeList(data.UpstreamLOSSCount);
+		data.DownstreamLOFSCount = decodeList(data.DownstreamLOFSCount);
+		data.UpstreamLOFSCount = decodeList(data.UpstreamLOFSCount);
+		data.DownstreamUASCount = decodeList(data.DownstreamUASCount);
+		data.UpstreamUASCount = decodeList(data.UpstreamUASCount);
+		data.FullInitCount = decodeList(data.FullInitCount);
+		data.FailedFullInitCount = decodeList(data.FailedFullInitCount);
+		data.RetrainCount = decodeList(data.RetrainCount);
+		data.FailedRetrainCount = decodeList(data.FailedRetrainCount);
+		data.LinkLossCount = decodeList(data.LinkLossCount);
 		return data;
 	}
 
@@ -1018,6 +1037,53 @@ var DSLGraphs = DSLGraphs || (function () {
 	}
 
 
+	function buildLinePath(path, bins, scaleY, offsetY, maxY, postScaleY, minYValid, maxYValid) {
+		var width = bins.GroupSize;
+
+		var lastValid = false, lastDrawn = false;
+		var last = 0.0;
+		var lastPosY = 0.0;
+
+		var count = bins.Data.length;
+		for (var i = 0; i < count; i++) {
+			var val = bins.Data[i];
+			var valid = val >= minYValid && val <= maxYValid;
+			var changed = last != val;
+			var drawn = false;
+
+			var posX = (i + 0.5) * width;
+			var posY = Math.max(0, Math.min(maxY, val)-offsetY)*scaleY - 0.5;
+
+			if (lastValid && !valid) {
+				path.lineTo(posX-0.5*width, lastPosY*postScaleY);
+			}
+			if (!lastValid && valid) {
+				path.moveTo(posX-0.5*width, posY*postScaleY);
+				lastPosY = posY;
+			}
+			if (valid && changed) {
+				if (lastValid) {
+					if (!lastDrawn) {
+						path.lineTo(posX-width, lastPosY*postScaleY);
+					}
+					path.lineTo(posX, posY*postScaleY);
+					drawn = true;
+				}
+				lastPosY = posY;
+			}
+
+			lastDrawn = drawn;
+			lastValid = valid;
+			last = val;
+		}
+
+		if (lastValid) {
+			path.lineTo(count*bins.GroupSize, lastPosY*postScaleY);
+		}
+
+	}
+
+
 	class BitsGraph {
 
 		constructor(canvas, params, data) {
@@ -1100,38 +1166,717 @@ var DSLGraphs = DSLGraphs || (function () {
 			buildBitsPath(pathUpstream, this._data.Bits.Upstream, scaleY);
 
 			ctx.translate(x, y+h);
-			ctx.scale(scaleX, -1);
+			ctx.scale(scaleX, -1);
+
+			ctx.lineWidth = strokeWidthPilotTones;
+			ctx.lineCap = "butt";
+			ctx.strokeStyle = this._base.colorPilotTones.toString();
+			ctx.stroke(pathPilotTones);
+
+			ctx.fillStyle = this._base.colorUpstream.toString();
+			ctx.fill(pathUpstream);
+
+			ctx.fillStyle = this._base.colorDownstream.toString();
+			ctx.fill(pathDownstream);
+
+			ctx.resetTransform();
+		}
+
+		_updateAxisLimits(data) {
+			let top = 15.166666667;
+
+			if (data) {
+				let res = determineBinsBitsAxisLimits(4, [
+					data.Bits.Downstream.Data,
+					data.Bits.Upstream.Data
+				]);
+
+				if (res.valid && res.max < top) {
+					top = res.max;
+				}
+			}
+
+			if (this._spec.legendYTop !== top) {
+				this._spec.legendYTop = top;
+				this._spec.legendYLabelEnd = Math.floor(top);
+
+				this._specChanged = true;
+			}
+		}
+
+		_setParams(params) {
+			this._spec.width = params.width;
+			this._spec.height =  params.height;
+			this._spec.scaleFactor = params.scaleFactor;
+			this._spec.fontSize = params.fontSize;
+			this._spec.colorBackground = params.colorBackground;
+			this._spec.colorForeground = params.colorForeground;
+			this._spec.legendEnabled = params.legend;
+
+			if (this._dynamicAxisLimits !== params.preferDynamicAxisLimits) {
+				this._dynamicAxisLimits = params.preferDynamicAxisLimits;
+
+				this._updateAxisLimits(this._dynamicAxisLimits ? this._data : null);
+			}
+
+			this._specChanged = true;
+		}
+
+		setParams(params) {
+			this._setParams(params);
+			this._draw();
+		}
+
+		_setData(data) {
+			if (this._data === undefined || !this._data != !data || (this._data && data &&
+					this._data.BinCount != data.BinCount)) {
+
+				var legendXData = getLegendX(data);
+				this._spec.legendXMax = legendXData.bins;
+				this._spec.legendXLabelEnd = legendXData.bins;
+
+				this._specChanged = true;
+			}
+
+			if (this._dynamicAxisLimits) {
+				this._updateAxisLimits(data);
+			}
+
+			this._data = data;
+			this._bands.setData(data);
+		}
+
+		setData(data) {
+			this._setData(data);
+			this._draw();
+		}
+
+	}
+
+
+	class SNRGraph {
+
+		constructor(canvas, params, data, history) {
+			this._canvas = canvas;
+			this._canvasMinMax = document.createElement("canvas");
+
+			this._base = new BaseGraphHelper();
+			this._bands = new BandsGraphHelper();
+
+			this._spec = new GraphSpec();
+			this._spec.legendXMin = 0;
+			this._spec.legendXLabelStart = 0;
+			this._spec.legendXLabelSteps = [50, 100, 200, 500, 1000, 1250, 2500, 5000, 10000],
+			this._spec.legendXLabelFormatFunc = formatLegendXLabelBinsFreq,
+			this._spec.legendXLabelDigits = 4.0;
+			this._spec.legendYLabelSteps = [1, 2, 5, 10];
+			this._spec.legendYLabelFormatFunc = formatLegendYLabelBins;
+			this._spec.legendYLabelDigits = 3.75;
+
+			this._specChanged = true;
+
+			this._setParams(params);
+			this._setData(data, history);
+
+			this._draw();
+		}
+
+		static legend() {
+			var legend = new Legend();
+
+			legend.title = "Signal-to-noise ratio (dB)";
+
+			return legend;
+		}
+
+		static legendWithHistory() {
+			var legend = new Legend();
+
+			legend.title = "Signal-to-noise ratio (dB)";
+			legend.items = [
+				new LegendItem(COLOR_BLUE, "Minimum"),
+				new LegendItem(COLOR_GREEN, "Maximum")
+			];
+
+			return legend;
+		}
+
+		_draw() {
+			if (this._specChanged) {
+				this._base.setSpec(this._spec);
+				this._specChanged = false;
+			}
+
+			var ctx = this._canvas.getContext("2d");
+			var ctxMinMax = this._canvasMinMax.getContext("2d");
+
+			this._base.draw(ctx);
+
+			if (!this._data) {
+				return;
+			}
+
+			var x = this._base.graphX;
+			var y = this._base.graphY;
+			var w = this._base.graphWidth;
+			var h = this._base.graphHeight;
+
+			var scaleX = w / this._bins;
+			var scaleY = h / (this._spec.legendYTop - this._spec.legendYBottom);
+
+			this._bands.draw(ctx, this._base, true);
+
+			var path = new Path2D();
+			var pathMin = new Path2D();
+			var pathMax = new Path2D();
+
+			buildSNRQLNPath(path, this._data.SNR.Downstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, -32, 95);
+			buildSNRQLNPath(path, this._data.SNR.Upstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, -32, 95);
+
+			if (this._history != null) {
+				buildSNRMinMaxPath(pathMin, pathMax, this._history.SNR.Downstream,
+					scaleY, this._spec.legendYBottom, this._spec.legendYTop, 1/scaleX);
+				buildSNRMinMaxPath(pathMin, pathMax, this._history.SNR.Upstream,
+					scaleY, this._spec.legendYBottom, this._spec.legendYTop, 1/scaleX);
+			}
+
+			ctx.translate(x, y+h);
+			ctx.scale(scaleX, -1);
+
+			ctx.fillStyle = this._base.colorNeutralFill.toString();
+			ctx.fill(path);
+
+			ctx.resetTransform();
+
+			if (this._history == null) {
+				return;
+			}
+
+			if (ctxMinMax.canvas.width != w || ctxMinMax.canvas.height != h) {
+				ctxMinMax.canvas.width = w;
+				ctxMinMax.canvas.height = h;
+			}
+
+			ctxMinMax.clearRect(0, 0, w, h);
+
+			// scaling of y by scaleX in order to not distort the lines
+			ctxMinMax.translate(0, h);
+			ctxMinMax.scale(scaleX, -scaleX);
+
+			ctxMinMax.lineWidth = this._spec.scaleFactor / scaleX;
+			ctxMinMax.lineCap = "butt";
+
+			ctxMinMax.globalCompositeOperation = "source-over";
+			ctxMinMax.strokeStyle = this._base.colorMinStroke.toString();
+			ctxMinMax.stroke(pathMin);
+
+			ctxMinMax.globalCompositeOperation = "multiply";
+			ctxMinMax.strokeStyle = this._base.colorMaxStroke.toString();
+			ctxMinMax.stroke(pathMax);
+
+			ctxMinMax.resetTransform();
+
+			ctx.drawImage(ctxMinMax.canvas, x, y);
+		}
+
+		_updateAxisLimits(data, history) {
+			let bottom = 0.0;
+			let top = 65.0;
+
+			if (data || history) {
+				let res = determineBinsFloatAxisLimits(-32, 95, 20, true, [
+					data ? data.SNR.Downstream.Data : [],
+					data ? data.SNR.Upstream.Data : [],
+					history ? history.SNR.Downstream.Min : [],
+					history ? history.SNR.Downstream.Max : [],
+					history ? history.SNR.Upstream.Min : [],
+					history ? history.SNR.Upstream.Max : []
+				]);
+
+				if (res.valid) {
+					bottom = res.min;
+					top = res.max;
+				}
+			}
+
+			if (this._spec.legendYBottom !== bottom || this._spec.legendYTop !== top) {
+				this._spec.legendYBottom = bottom;
+				this._spec.legendYTop = top;
+				this._spec.legendYLabelStart = Math.ceil(bottom);
+				this._spec.legendYLabelEnd = Math.floor(top);
+
+				this._specChanged = true;
+			}
+		}
+
+		_setParams(params) {
+			this._spec.width = params.width;
+			this._spec.height =  params.height;
+			this._spec.scaleFactor = params.scaleFactor;
+			this._spec.fontSize = params.fontSize;
+			this._spec.colorBackground = params.colorBackground;
+			this._spec.colorForeground = params.colorForeground;
+			this._spec.legendEnabled = params.legend;
+
+			if (this._dynamicAxisLimits !== params.preferDynamicAxisLimits) {
+				this._dynamicAxisLimits = params.preferDynamicAxisLimits;
+
+				if (this._dynamicAxisLimits) {
+					this._updateAxisLimits(this._data, this._history);
+				} else {
+					this._updateAxisLimits(null, null);
+				}
+			}
+
+			this._specChanged = true;
+		}
+
+		setParams(params) {
+			this._setParams(params);
+			this._draw();
+		}
+
+		_setData(data, history) {
+			if (this._data === undefined || !this._data != !data || (this._data && data &&
+					(this._data.BinCount != data.BinCount || this._data.CarrierSpacing != data.CarrierSpacing))) {
+
+				var legendXData = getLegendX(data);
+				this._bins = legendXData.bins;
+				this._spec.legendXMax = legendXData.freq;
+				this._spec.legendXLabelEnd = Math.floor(legendXData.freq);
+
+				this._specChanged = true;
+			}
+
+			if (this._history === undefined || !this._history != !history || (this._history && history &&
+					(this._history.SNR.Downstream.GroupSize != history.SNR.Downstream.GroupSize ||
+						this._history.SNR.Upstream.GroupSize != history.SNR.Upstream.GroupSize))) {
+
+				if (history && (history.SNR.Downstream.GroupSize != 0 || history.SNR.Upstream.GroupSize != 0)) {
+					this._spec.legendData = this.constructor.legendWithHistory();
+				} else {
+					this._spec.legendData = this.constructor.legend();
+				}
+
+				this._specChanged = true;
+			}
+
+			if (this._dynamicAxisLimits) {
+				this._updateAxisLimits(data, history);
+			}
+
+			this._data = data;
+			this._history = history;
+			this._bands.setData(data);
+		}
+
+		setData(data, history) {
+			this._setData(data, history);
+			this._draw();
+		}
+
+	}
+
+
+	class QLNGraph {
+
+		constructor(canvas, params, data) {
+			this._canvas = canvas;
+
+			this._base = new BaseGraphHelper();
+			this._bands = new BandsGraphHelper();
+
+			this._spec = new GraphSpec();
+			this._spec.legendXMin = 0;
+			this._spec.legendXLabelStart = 0;
+			this._spec.legendXLabelSteps = [50, 100, 200, 500, 1000, 1250, 2500, 5000, 10000],
+			this._spec.legendXLabelFormatFunc = formatLegendXLabelBinsFreq,
+			this._spec.legendXLabelDigits = 4.0;
+			this._spec.legendYLabelSteps = [1, 2, 5, 10, 20];
+			this._spec.legendYLabelFormatFunc = formatLegendYLabelBins;
+			this._spec.legendYLabelDigits = 3.75;
+			this._spec.legendData = this.constructor.legend();
+
+			this._specChanged = true;
+
+			this._setParams(params);
+			this._setData(data);
+
+			this._draw();
+		}
+
+		static legend() {
+			var legend = new Legend();
+
+			legend.title = "Quiet line noise (dBm/Hz)";
+
+			return legend;
+		}
+
+		_draw() {
+			if (this._specChanged) {
+				this._base.setSpec(this._spec);
+				this._specChanged = false;
+			}
+
+			var ctx = this._canvas.getContext("2d");
+
+			this._base.draw(ctx);
+
+			if (!this._data) {
+				return;
+			}
+
+			var x = this._base.graphX;
+			var y = this._base.graphY;
+			var w = this._base.graphWidth;
+			var h = this._base.graphHeight;
+
+			var scaleX = w / this._bins;
+			var scaleY = h / (this._spec.legendYTop - this._spec.legendYBottom);
+
+			this._bands.draw(ctx, this._base, true);
+
+			var path = new Path2D();
+
+			buildSNRQLNPath(path, this._data.QLN.Downstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, -150, -23);
+			buildSNRQLNPath(path, this._data.QLN.Upstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, -150, -23);
+
+			ctx.translate(x, y+h);
+			ctx.scale(scaleX, -1);
+
+			ctx.fillStyle = this._base.colorNeutralFill.toString();
+			ctx.fill(path);
+
+			ctx.resetTransform();
+		}
+
+		_updateAxisLimits(data) {
+			let bottom = -160.0;
+			let top = -69.0;
+
+			if (data) {
+				let res = determineBinsFloatAxisLimits(-150, -23, 20, false, [
+					data.QLN.Downstream.Data,
+					data.QLN.Upstream.Data
+				]);
+
+				if (res.valid) {
+					bottom = res.min;
+					top = res.max;
+				}
+			}
+
+			if (this._spec.legendYBottom !== bottom || this._spec.legendYTop !== top) {
+				this._spec.legendYBottom = bottom;
+				this._spec.legendYTop = top;
+				this._spec.legendYLabelStart = Math.ceil(bottom);
+				this._spec.legendYLabelEnd = Math.floor(top);
+
+				this._specChanged = true;
+			}
+		}
+
+		_setParams(params) {
+			this._spec.width = params.width;
+			this._spec.height =  params.height;
+			this._spec.scaleFactor = params.scaleFactor;
+			this._spec.fontSize = params.fontSize;
+			this._spec.colorBackground = params.colorBackground;
+			this._spec.colorForeground = params.colorForeground;
+			this._spec.legendEnabled = params.legend;
+
+			if (this._dynamicAxisLimits !== params.preferDynamicAxisLimits) {
+				this._dynamicAxisLimits = params.preferDynamicAxisLimits;
+
+				this._updateAxisLimits(this._dynamicAxisLimits ? this._data : null);
+			}
+
+			this._specChanged = true;
+		}
+
+		setParams(params) {
+			this._setParams(params);
+			this._draw();
+		}
+
+		_setData(data) {
+			if (this._data === undefined || !this._data != !data || (this._data && data &&
+					(this._data.BinCount != data.BinCount || this._data.CarrierSpacing != data.CarrierSpacing))) {
+
+				var legendXData = getLegendX(data);
+				this._bins = legendXData.bins;
+				this._spec.legendXMax = legendXData.freq;
+				this._spec.legendXLabelEnd = Math.floor(legendXData.freq);
+
+				this._specChanged = true;
+			}
+
+			if (this._dynamicAxisLimits) {
+				this._updateAxisLimits(data);
+			}
+
+			this._data = data;
+			this._bands.setData(data);
+		}
+
+		setData(data) {
+			this._setData(data);
+			this._draw();
+		}
+
+	}
+
+
+	class HlogGraph {
+
+		constructor(canvas, params, data) {
+			this._canvas = canvas;
+
+			this._base = new BaseGraphHelper();
+			this._bands = new BandsGraphHelper();
+
+			this._spec = new GraphSpec();
+			this._spec.legendXMin = 0;
+			this._spec.legendXLabelStart = 0;
+			this._spec.legendXLabelSteps = [50, 100, 200, 500, 1000, 1250, 2500, 5000, 10000],
+			this._spec.legendXLabelFormatFunc = formatLegendXLabelBinsFreq,
+			this._spec.legendXLabelDigits = 4.0;
+			this._spec.legendYLabelSteps = [1, 2, 5, 10, 20];
+			this._spec.legendYLabelFormatFunc = formatLegendYLabelBins;
+			this._spec.legendYLabelDigits = 3.75;
+			this._spec.legendData = this.constructor.legend();
+
+			this._specChanged = true;
+
+			this._setParams(params);
+			this._setData(data);
+
+			this._draw();
+		}
+
+		static legend() {
+			var legend = new Legend();
+
+			legend.title = "Channel characteristic (dB)";
+
+			return legend;
+		}
+
+		_draw() {
+			if (this._specChanged) {
+				this._base.setSpec(this._spec);
+				this._specChanged = false;
+			}
+
+			var ctx = this._canvas.getContext("2d");
+
+			this._base.draw(ctx);
+
+			if (!this._data) {
+				return;
+			}
+
+			var x = this._base.graphX;
+			var y = this._base.graphY;
+			var w = this._base.graphWidth;
+			var h = this._base.graphHeight;
+
+			var scaleX = w / this._bins;
+			var scaleY = h / (this._spec.legendYTop - this._spec.legendYBottom)
+
+			this._bands.draw(ctx, this._base, true);
+
+			var path = new Path2D();
+
+			buildHlogPath(path, this._data.Hlog.Downstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, 1/scaleX);
+			buildHlogPath(path, this._data.Hlog.Upstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, 1/scaleX);
+
+			// scaling of y by scaleX in order to not distort the line
+			ctx.translate(x, y+h);
+			ctx.scale(scaleX, -scaleX);
+
+			ctx.lineWidth = this._spec.scaleFactor / scaleX;
+			ctx.lineCap = "butt";
+			ctx.strokeStyle = this._base.colorNeutralStroke.toString();
+			ctx.stroke(path);
+
+			ctx.resetTransform();
+		}
+
+		_updateAxisLimits(data) {
+			let bottom = -100.0;
+			let top = 7.0;
+
+			if (data) {
+				let res = determineBinsFloatAxisLimits(-96.2, 6, 20, false, [
+					data.Hlog.Downstream.Data,
+					data.Hlog.Upstream.Data
+				]);
+
+				if (res.valid) {
+					bottom = res.min;
+					top = res.max;
+				}
+			}
+
+			if (this._spec.legendYBottom !== bottom || this._spec.legendYTop !== top) {
+				this._spec.legendYBottom = bottom;
+				this._spec.legendYTop = top;
+				this._spec.legendYLabelStart = Math.ceil(bottom);
+				this._spec.legendYLabelEnd = Math.floor(top);
+
+				this._specChanged = true;
+			}
+		}
+
+		_setParams(params) {
+			this._spec.width = params.width;
+			this._spec.height =  params.height;
+			this._spec.scaleFactor = params.scaleFactor;
+			this._spec.fontSize = params.fontSize;
+			this._spec.colorBackground = params.colorBackground;
+			this._spec.colorForeground = params.colorForeground;
+			this._spec.legendEnabled = params.legend;
+
+			if (this._dynamicAxisLimits !== params.preferDynamicAxisLimits) {
+				this._dynamicAxisLimits = params.preferDynamicAxisLimits;
+
+				this._updateAxisLimits(this._dynamicAxisLimits ? this._data : null);
+			}
+
+			this._specChanged = true;
+		}
+
+		setParams(params) {
+			this._setParams(params);
+			this._draw();
+		}
+
+		_setData(data) {
+			if (this._data === undefined || !this._data != !data || (this._data && data &&
+					(this._data.BinCount != data.BinCount || this._data.CarrierSpacing != data.CarrierSpacing))) {
+
+				var legendXData = getLegendX(data);
+				this._bins = legendXData.bins;
+				this._spec.legendXMax = legendXData.freq;
+				this._spec.legendXLabelEnd = Math.floor(legendXData.freq);
+
+				this._specChanged = true;
+			}
+
+			if (this._dynamicAxisLimits) {
+				this._updateAxisLimits(data);
+			}
+
+			this._data = data;
+			this._bands.setData(data);
+		}
+
+		setData(data) {
+			this._setData(data);
+			this._draw();
+		}
+
+	}
+
+
+	class PSDGraph {
+
+		constructor(canvas, params, data) {
+			this._canvas = canvas;
+
+			this._base = new BaseGraphHelper();
+			this._bands = new BandsGraphHelper();
+
+			this._spec = new GraphSpec();
+			this._spec.legendXMin = 0;
+			this._spec.legendXLabelStart = 0;
+			this._spec.legendXLabelSteps = [50, 100, 200, 500, 1000, 1250, 2500, 5000, 10000],
+			this._spec.legendXLabelFormatFunc = formatLegendXLabelBinsFreq,
+			this._spec.legendXLabelDigits = 4.0;
+			this._spec.legendYLabelSteps = [1, 2, 5, 10, 20];
+			this._spec.legendYLabelFormatFunc = formatLegendYLabelBins;
+			this._spec.legendYLabelDigits = 3.75;
+			this._spec.legendData = this.constructor.legend();
+
+			this._specChanged = true;
+
+			this._setParams(params);
+			this._setData(data);
+
+			this._draw();
+		}
+
+		static legend() {
+			var legend = new Legend();
+
+			legend.title = "Transmit PSD mask (dBm/Hz)";
+
+			return legend;
+		}
+
+		_draw() {
+			if (this._specChanged) {
+				this._base.setSpec(this._spec);
+				this._specChanged = false;
+			}
+
+			var ctx = this._canvas.getContext("2d");
+
+			this._base.draw(ctx);
+
+			if (!this._data) {
+				return;
+			}
+
+			var x = this._base.graphX;
+			var y = this._base.graphY;
+			var w = this._base.graphWidth;
+			var h = this._base.graphHeight;
+
+			var scaleX = w / this._bins;
+			var scaleY = h / (this._spec.legendYTop - this._spec.legendYBottom)
+
+			this._bands.draw(ctx, this._base, true);
+
+			var path = new Path2D();
+
+			buildLinePath(path, this._data.PSD.Downstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, 1/scaleX, -150, -20);
+			buildLinePath(path, this._data.PSD.Upstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, 1/scaleX, -150, -20);
+
+			// scaling of y by scaleX in order to not distort the line
+			ctx.translate(x, y+h);
+			ctx.scale(scaleX, -scaleX);
 
-			ctx.lineWidth = strokeWidthPilotTones;
+			ctx.lineWidth = this._spec.scaleFactor / scaleX;
 			ctx.lineCap = "butt";
-			ctx.strokeStyle = this._base.colorPilotTones.toString();
-			ctx.stroke(pathPilotTones);
-
-			ctx.fillStyle = this._base.colorUpstream.toString();
-			ctx.fill(pathUpstream);
-
-			ctx.fillStyle = this._base.colorDownstream.toString();
-			ctx.fill(pathDownstream);
+			ctx.strokeStyle = this._base.colorNeutralStroke.toString();
+			ctx.stroke(path);
 
 			ctx.resetTransform();
 		}
 
 		_updateAxisLimits(data) {
-			let top = 15.166666667;
+			let bottom = -100.0;
+			let top = -30.0;
 
 			if (data) {
-				let res = determineBinsBitsAxisLimits(4, [
-					data.Bits.Downstream.Data,
-					data.Bits.Upstream.Data
+				let res = determineBinsFloatAxisLimits(-150, -20, 20, false, [
+					data.PSD.Downstream.Data,
+					data.PSD.Upstream.Data
 				]);
 
-				if (res.valid && res.max < top) {
+				if (res.valid) {
+					bottom = res.min;
 					top = res.max;
 				}
 			}
 
-			if (this._spec.legendYTop !== top) {
+			if (this._spec.legendYBottom !== bottom || this._spec.legendYTop !== top) {
+				this._spec.legendYBottom = bottom;
 				this._spec.legendYTop = top;
+				this._spec.legendYLabelStart = Math.ceil(bottom);
 				this._spec.legendYLabelEnd = Math.floor(top);
 
 				this._specChanged = true;
@@ -1163,11 +1908,12 @@ var DSLGraphs = DSLGraphs || (function () {
 
 		_setData(data) {
 			if (this._data === undefined || !this._data != !data || (this._data && data &&
-					this._data.BinCount != data.BinCount)) {
+					(this._data.BinCount != data.BinCount || this._data.CarrierSpacing != data.CarrierSpacing))) {
 
 				var legendXData = getLegendX(data);
-				this._spec.legendXMax = legendXData.bins;
-				this._spec.legendXLabelEnd = legendXData.bins;
+				this._bins = legendXData.bins;
+				this._spec.legendXMax = legendXData.freq;
+				this._spec.legendXLabelEnd = Math.floor(legendXData.freq);
 
 				this._specChanged = true;
 			}
@@ -1188,11 +1934,10 @@ var DSLGraphs = DSLGraphs || (function () {
 	}
 
 
-	class SNRGraph {
+	class FEXTCancellationGraph {
 
-		constructor(canvas, params, data, history) {
+		constructor(canvas, params, data) {
 			this._canvas = canvas;
-			this._canvasMinMax = document.createElement("canvas");
 
 			this._base = new BaseGraphHelper();
 			this._bands = new BandsGraphHelper();
@@ -1206,11 +1951,12 @@ var DSLGraphs = DSLGraphs || (function () {
 			this._spec.legendYLabelSteps = [1, 2, 5, 10];
 			this._spec.legendYLabelFormatFunc = formatLegendYLabelBins;
 			this._spec.legendYLabelDigits = 3.75;
+			this._spec.legendData = this.constructor.legend();
 
 			this._specChanged = true;
 
 			this._setParams(params);
-			this._setData(data, history);
+			this._setData(data);
 
 			this._draw();
 		}
@@ -1218,19 +1964,7 @@ var DSLGraphs = DSLGraphs || (function () {
 		static legend() {
 			var legend = new Legend();
 
-			legend.title = "Signal-to-noise ratio (dB)";
-
-			return legend;
-		}
-
-		static legendWithHistory() {
-			var legend = new Legend();
-
-			legend.title = "Signal-to-noise ratio (dB)";
-			legend.items = [
-				new LegendItem(COLOR_BLUE, "Minimum"),
-				new LegendItem(COLOR_GREEN, "Maximum")
-			];
+			legend.title = "FEXT cancellation gain (dB)";
 
 			return legend;
 		}
@@ -1242,7 +1976,6 @@ var DSLGraphs = DSLGraphs || (function () {
 			}
 
 			var ctx = this._canvas.getContext("2d");
-			var ctxMinMax = this._canvasMinMax.getContext("2d");
 
 			this._base.draw(ctx);
 
@@ -1261,18 +1994,9 @@ var DSLGraphs = DSLGraphs || (function () {
 			this._bands.draw(ctx, this._base, true);
 
 			var path = new Path2D();
-			var pathMin = new Path2D();
-			var pathMax = new Path2D();
-
-			buildSNRQLNPath(path, this._data.SNR.Downstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, -32, 95);
-			buildSNRQLNPath(path, this._data.SNR.Upstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, -32, 95);
 
-			if (this._history != null) {
-				buildSNRMinMaxPath(pathMin, pathMax, this._history.SNR.Downstream,
-					scaleY, this._spec.legendYBottom, this._spec.legendYTop, 1/scaleX);
-				buildSNRMinMaxPath(pathMin, pathMax, this._history.SNR.Upstream,
-					scaleY, this._spec.legendYBottom, this._spec.legendYTop, 1/scaleX);
-			}
+			buildSNRQLNPath(path, this._data.FEXTCancellation.Downstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, 0, 100);
+			buildSNRQLNPath(path, this._data.FEXTCancellation.Upstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, 0, 100);
 
 			ctx.translate(x, y+h);
 			ctx.scale(scaleX, -1);
@@ -1281,54 +2005,19 @@ var DSLGraphs = DSLGraphs || (function () {
 			ctx.fill(path);
 
 			ctx.resetTransform();
-
-			if (this._history == null) {
-				return;
-			}
-
-			if (ctxMinMax.canvas.width != w || ctxMinMax.canvas.height != h) {
-				ctxMinMax.canvas.width = w;
-				ctxMinMax.canvas.height = h;
-			}
-
-			ctxMinMax.clearRect(0, 0, w, h);
-
-			// scaling of y by scaleX in order to not distort the lines
-			ctxMinMax.translate(0, h);
-			ctxMinMax.scale(scaleX, -scaleX);
-
-			ctxMinMax.lineWidth = this._spec.scaleFactor / scaleX;
-			ctxMinMax.lineCap = "butt";
-
-			ctxMinMax.globalCompositeOperation = "source-over";
-			ctxMinMax.strokeStyle = this._base.colorMinStroke.toString();
-			ctxMinMax.stroke(pathMin);
-
-			ctxMinMax.globalCompositeOperation = "multiply";
-			ctxMinMax.strokeStyle = this._base.colorMaxStroke.toString();
-			ctxMinMax.stroke(pathMax);
-
-			ctxMinMax.resetTransform();
-
-			ctx.drawImage(ctxMinMax.canvas, x, y);
 		}
 
-		_updateAxisLimits(data, history) {
+		_updateAxisLimits(data) {
 			let bottom = 0.0;
-			let top = 65.0;
+			let top = 50.0;
 
-			if (data || history) {
-				let res = determineBinsFloatAxisLimits(-32, 95, 20, true, [
-					data ? data.SNR.Downstream.Data : [],
-					data ? data.SNR.Upstream.Data : [],
-					history ? history.SNR.Downstream.Min : [],
-					history ? history.SNR.Downstream.Max : [],
-					history ? history.SNR.Upstream.Min : [],
-					history ? history.SNR.Upstream.Max : []
+			if (data) {
+				let res = determineBinsFloatAxisLimits(0, 100, 20, true, [
+					data.FEXTCancellation.Downstream.Data,
+					data.FEXTCancellation.Upstream.Data
 				]);
 
 				if (res.valid) {
-					bottom = res.min;
 					top = res.max;
 				}
 			}
@@ -1355,11 +2044,7 @@ var DSLGraphs = DSLGraphs || (function () {
 			if (this._dynamicAxisLimits !== params.preferDynamicAxisLimits) {
 				this._dynamicAxisLimits = params.preferDynamicAxisLimits;
 
-				if (this._dynamicAxisLimits) {
-					this._updateAxisLimits(this._data, this._history);
-				} else {
-					this._updateAxisLimits(null, null);
-				}
+				this._updateAxisLimits(this._dynamicAxisLimits ? this._data : null);
 			}
 
 			this._specChanged = true;
@@ -1370,7 +2055,7 @@ var DSLGraphs = DSLGraphs || (function () {
 			this._draw();
 		}
 
-		_setData(data, history) {
+		_setData(data) {
 			if (this._data === undefined || !this._data != !data || (this._data && data &&
 					(this._data.BinCount != data.BinCount || this._data.CarrierSpacing != data.CarrierSpacing))) {
 
@@ -1382,37 +2067,23 @@ var DSLGraphs = DSLGraphs || (function () {
 				this._specChanged = true;
 			}
 
-			if (this._history === undefined || !this._history != !history || (this._history && history &&
-					(this._history.SNR.Downstream.GroupSize != history.SNR.Downstream.GroupSize ||
-						this._history.SNR.Upstream.GroupSize != history.SNR.Upstream.GroupSize))) {
-
-				if (history && (history.SNR.Downstream.GroupSize != 0 || history.SNR.Upstream.GroupSize != 0)) {
-					this._spec.legendData = this.constructor.legendWithHistory();
-				} else {
-					this._spec.legendData = this.constructor.legend();
-				}
-
-				this._specChanged = true;
-			}
-
 			if (this._dynamicAxisLimits) {
-				this._updateAxisLimits(data, history);
+				this._updateAxisLimits(data);
 			}
 
 			this._data = data;
-			this._history = history;
 			this._bands.setData(data);
 		}
 
-		setData(data, history) {
-			this._setData(data, history);
+		setData(data) {
+			this._setData(data);
 			this._draw();
 		}
 
 	}
 
 
-	class QLNGraph {
+	class HlinGraph {
 
 		constructor(canvas, params, data) {
 			this._canvas = canvas;
@@ -1442,7 +2113,7 @@ var DSLGraphs = DSLGraphs || (function () {
 		static legend() {
 			var legend = new Legend();
 
-			legend.title = "Quiet line noise (dBm/Hz)";
+			legend.title = "Channel characteristic Hlin (dB)";
 
 			return legend;
 		}
@@ -1467,32 +2138,35 @@ var DSLGraphs = DSLGraphs || (function () {
 			var h = this._base.graphHeight;
 
 			var scaleX = w / this._bins;
-			var scaleY = h / (this._spec.legendYTop - this._spec.legendYBottom);
+			var scaleY = h / (this._spec.legendYTop - this._spec.legendYBottom)
 
 			this._bands.draw(ctx, this._base, true);
 
 			var path = new Path2D();
 
-			buildSNRQLNPath(path, this._data.QLN.Downstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, -150, -23);
-			buildSNRQLNPath(path, this._data.QLN.Upstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, -150, -23);
+			buildHlogPath(path, this._data.Hlin.Downstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, 1/scaleX);
+			buildHlogPath(path, this._data.Hlin.Upstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, 1/scaleX);
 
+			// scaling of y by scaleX in order to not distort the line
 			ctx.translate(x, y+h);
-			ctx.scale(scaleX, -1);
+			ctx.scale(scaleX, -scaleX);
 
-			ctx.fillStyle = this._base.colorNeutralFill.toString();
-			ctx.fill(path);
+			ctx.lineWidth = this._spec.scaleFactor / scaleX;
+			ctx.lineCap = "butt";
+			ctx.strokeStyle = this._base.colorNeutralStroke.toString();
+			ctx.stroke(path);
 
 			ctx.resetTransform();
 		}
 
 		_updateAxisLimits(data) {
-			let bottom = -160.0;
-			let top = -69.0;
+			let bottom = -100.0;
+			let top = 7.0;
 
 			if (data) {
-				let res = determineBinsFloatAxisLimits(-150, -23, 20, false, [
-					data.QLN.Downstream.Data,
-					data.QLN.Upstream.Data
+				let res = determineBinsFloatAxisLimits(-96.2, 6, 20, false, [
+					data.Hlin.Downstream.Data,
+					data.Hlin.Upstream.Data
 				]);
 
 				if (res.valid) {
@@ -1562,7 +2236,7 @@ var DSLGraphs = DSLGraphs || (function () {
 	}
 
 
-	class HlogGraph {
+	class GainsGraph {
 
 		constructor(canvas, params, data) {
 			this._canvas = canvas;
@@ -1576,7 +2250,7 @@ var DSLGraphs = DSLGraphs || (function () {
 			this._spec.legendXLabelSteps = [50, 100, 200, 500, 1000, 1250, 2500, 5000, 10000],
 			this._spec.legendXLabelFormatFunc = formatLegendXLabelBinsFreq,
 			this._spec.legendXLabelDigits = 4.0;
-			this._spec.legendYLabelSteps = [1, 2, 5, 10, 20];
+			this._spec.legendYLabelSteps = [1, 2, 5, 10];
 			this._spec.legendYLabelFormatFunc = formatLegendYLabelBins;
 			this._spec.legendYLabelDigits = 3.75;
 			this._spec.legendData = this.constructor.legend();
@@ -1592,7 +2266,7 @@ var DSLGraphs = DSLGraphs || (function () {
 		static legend() {
 			var legend = new Legend();
 
-			legend.title = "Channel characteristic (dB)";
+			legend.title = "Fine gains (dB)";
 
 			return legend;
 		}
@@ -1623,8 +2297,8 @@ var DSLGraphs = DSLGraphs || (function () {
 
 			var path = new Path2D();
 
-			buildHlogPath(path, this._data.Hlog.Downstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, 1/scaleX);
-			buildHlogPath(path, this._data.Hlog.Upstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, 1/scaleX);
+			buildLinePath(path, this._data.Gains.Downstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, 1/scaleX, -14.5, 2.5);
+			buildLinePath(path, this._data.Gains.Upstream, scaleY, this._spec.legendYBottom, this._spec.legendYTop, 1/scaleX, -14.5, 2.5);
 
 			// scaling of y by scaleX in order to not distort the line
 			ctx.translate(x, y+h);
@@ -1639,13 +2313,13 @@ var DSLGraphs = DSLGraphs || (function () {
 		}
 
 		_updateAxisLimits(data) {
-			let bottom = -100.0;
-			let top = 7.0;
+			let bottom = -15.0;
+			let top = 3.0;
 
 			if (data) {
-				let res = determineBinsFloatAxisLimits(-96.2, 6, 20, false, [
-					data.Hlog.Downstream.Data,
-					data.Hlog.Upstream.Data
+				let res = determineBinsFloatAxisLimits(-14.5, 2.5, 5, false, [
+					data.Gains.Downstream.Data,
+					data.Gains.Upstream.Data
 				]);
 
 				if (res.valid) {
@@ -2243,6 +2917,122 @@ var DSLGraphs = DSLGraphs || (function () {
 	}
 
 
+	class DownstreamFailureSecondsGraph extends ErrorsGraph {
+
+		static legend() {
+			var legend = new Legend();
+
+			legend.title = "Downstream failure seconds";
+			legend.items = [
+				new LegendItem(COLOR_GREEN, "Unavailable (UAS)"),
+				new LegendItem(COLOR_BLUE, "Loss of signal (LOSS)"),
+				new LegendItem(COLOR_RED, "Loss of frame (LOFS)")
+			];
+
+			return legend;
+		}
+
+		_getItems(data) {
+			if (data) {
+				return [
+					{data: data.DownstreamUASCount, color: COLOR_GREEN},
+					{data: data.DownstreamLOSSCount, color: COLOR_BLUE},
+					{data: data.DownstreamLOFSCount, color: COLOR_RED}
+				];
+			}
+			return [];
+		}
+
+	}
+
+
+	class UpstreamFailureSecondsGraph extends ErrorsGraph {
+
+		static legend() {
+			var legend = new Legend();
+
+			legend.title = "Upstream failure seconds";
+			legend.items = [
+				new LegendItem(COLOR_GREEN, "Unavailable (UAS)"),
+				new LegendItem(COLOR_BLUE, "Loss of signal (LOSS)"),
+				new LegendItem(COLOR_RED, "Loss of frame (LOFS)")
+			];
+
+			return legend;
+		}
+
+		_getItems(data) {
+			if (data) {
+				return [
+					{data: data.UpstreamUASCount, color: COLOR_GREEN},
+					{data: data.UpstreamLOSSCount, color: COLOR_BLUE},
+					{data: data.UpstreamLOFSCount, color: COLOR_RED}
+				];
+			}
+			return [];
+		}
+
+	}
+
+
+	class FullInitsGraph extends ErrorsGraph {
+
+		static legend() {
+			var legend = new Legend();
+
+			legend.title = "Full initializations";
+			legend.items = [
+				new LegendItem(COLOR_GREEN, "Successful"),
+				new LegendItem(COLOR_BLUE, "Failed"),
+				new LegendItem(COLOR_RED, "Loss of link (LOL)")
+			];
+
+			return legend;
+		}
+
+		_getItems(data) {
+			if (data) {
+				return [
+					{data: data.FullInitCount, color: COLOR_GREEN},
+					{data: data.FailedFullInitCount, color: COLOR_BLUE},
+					{data: data.LinkLossCount, color: COLOR_RED}
+				];
+			}
+			return [];
+		}
+
+	}
+
+
+	class RetrainsGraph extends ErrorsGraph {
+
+		static legend() {
+			var legend = new Legend();
+
+			legend.title = "Retrains";
+			legend.items = [
+				new LegendItem(COLOR_GREEN, "Successful"),
+				new LegendItem(COLOR_BLUE, "Failed"),
+				new LegendItem(COLOR_RED, "Loss of link (LOL)")
+			];
+
+			return legend;
+		}
+
+		_getItems(data) {
+			if (data) {
+				return [
+					{data: data.RetrainCount, color: COLOR_GREEN},
+					{data: data.FailedRetrainCount, color: COLOR_BLUE},
+					{data: data.LinkLossCount, color: COLOR_RED}
+				];
+			}
+			return [];
+		}
+
+	}
+
+
 	return {
 		decodeBins: decodeBins,
 		decodeBinsHistory: decodeBinsHistory,
@@ -2253,12 +3043,20 @@ var DSLGraphs = DSLGraphs || (function () {
 		SNRGraph: SNRGraph,
 		QLNGraph: QLNGraph,
 		HlogGraph: HlogGraph,
+		PSDGraph: PSDGraph,
+		FEXTCancellationGraph: FEXTCancellationGraph,
+		HlinGraph: HlinGraph,
+		GainsGraph: GainsGraph,
 		DownstreamRetransmissionGraph: DownstreamRetransmissionGraph,
 		UpstreamRetransmissionGraph: UpstreamRetransmissionGraph,
 		DownstreamErrorsGraph: DownstreamErrorsGraph,
 		UpstreamErrorsGraph: UpstreamErrorsGraph,
 		DownstreamErrorSecondsGraph: DownstreamErrorSecondsGraph,
-		UpstreamErrorSecondsGraph: UpstreamErrorSecondsGraph
+		UpstreamErrorSecondsGraph: UpstreamErrorSecondsGraph,
+		DownstreamFailureSecondsGraph: DownstreamFailureSecondsGraph,
+		UpstreamFailureSecondsGraph: UpstreamFailureSecondsGraph,
+		FullInitsGraph: FullInitsGraph,
+		RetrainsGraph: RetrainsGraph
 	}
 
 })();
@@ -89,6 +89,33 @@ type hlogModel struct {
 	Path        path
 }
 
+type hlinModel struct {
+	baseModel
+	StrokeWidth float64
+	Transform   transform
+	Path        path
+}
+
+type gainsModel struct {
+	baseModel
+	StrokeWidth float64
+	Transform   transform
+	Path        path
+}
+
+type psdModel struct {
+	baseModel
+	StrokeWidth float64
+	Transform   transform
+	Path        path
+}
+
+type fextCancellationModel struct {
+	baseModel
+	Transform transform
+	Path      path
+}
+
 type coloredPath struct {
 	Color Color
 	Path  path
@@ -25,6 +25,18 @@ var templateQLN string
 //go:embed templates/hlog.tmpl
 var templateHlog string
 
+//go:embed templates/hlin.tmpl
+var templateHlin string
+
+//go:embed templates/gains.tmpl
+var templateGains string
+
+//go:embed templates/psd.tmpl
+var templatePSD string
+
+//go:embed templates/fext.tmpl
+var templateFEXTCancellation string
+
 //go:embed templates/errors.tmpl
 var templateErrors string
 
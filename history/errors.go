@@ -44,6 +44,23 @@ type errorsHistoryItem struct {
 
 	DownstreamSESCount models.IntValue
 	UpstreamSESCount   models.IntValue
+
+	DownstreamLOSSCount models.IntValue
+	UpstreamLOSSCount   models.IntValue
+
+	DownstreamLOFSCount models.IntValue
+	UpstreamLOFSCount   models.IntValue
+
+	DownstreamUASCount models.IntValue
+	UpstreamUASCount   models.IntValue
+
+	FullInitCount       models.IntValue
+	FailedFullInitCount models.IntValue
+
+	RetrainCount       models.IntValue
+	FailedRetrainCount models.IntValue
+
+	LinkLossCount models.IntValue
 }
 
 type Errors struct {
@@ -72,32 +89,32 @@ func updateErrorValueShowtime(out *models.BoolValue, val models.State) {
 }
 
 func updateErrorValue(out *models.IntValue, lastVal, val models.IntValue) {
-	if !lastVal.Valid || !val.Valid {
+	delta := models.CalculateCounterDelta(lastVal, val)
+	if !delta.Delta.Valid {
 		return
 	}
 
-	diff := val.Int - lastVal.Int
+	out.Valid = true
+	out.Int += delta.Delta.Int
+}
 
-	if lastVal.Int > val.Int {
-		// The counters are very likely implemented as 32 bit unsigned integers on the device (as that is
-		// the type used in the DSL standards). If an overflow seems plausible, calculate the difference in
-		// 32 bit unsigned arithmetic.
-		if lastVal.Int >= 1<<31 && lastVal.Int < 1<<32 && val.Int >= 0 && val.Int < 1<<31 {
-			diff = int64(uint32(val.Int) - uint32(lastVal.Int))
-		} else {
-			return
-		}
+// updateLinkLossValue counts a loss of link (LOL) if the line dropped from showtime or resynced since the last
+// update (as indicated by a decreased uptime).
+func updateLinkLossValue(out *models.IntValue, lastStatus, status models.Status) {
+	if lastStatus.State == models.StateUnknown || status.State == models.StateUnknown {
+		return
 	}
 
-	// Reject unreasonably large differences. This is mainly to work around an issue in Lantiq devices.
-	// Shortly after a resync (but not necessarily within the first minute), the downstream FEC counter
-	// may increase by a value close to 2^32.
-	if diff >= 1<<31 {
+	out.Valid = true
+
+	if lastStatus.State != models.StateShowtime {
 		return
 	}
 
-	out.Valid = true
-	out.Int += diff
+	if status.State != models.StateShowtime ||
+		(lastStatus.Uptime.Valid && status.Uptime.Valid && lastStatus.Uptime.Duration > status.Uptime.Duration) {
+		out.Int++
+	}
 }
 
 func NewErrors(config ErrorsConfig) (*Errors, error) {
@@ -174,6 +191,14 @@ func (h *Errors) Update(status models.Status, now time.Time) {
 	currentItem := &h.data[h.periodIndex]
 
 	updateErrorValueShowtime(&currentItem.Showtime, status.State)
+	updateLinkLossValue(&currentItem.LinkLossCount, h.lastStatus, status)
+
+	// these counters are usually not reset on resync, and they change mostly outside of showtime
+	updateErrorValue(&currentItem.FullInitCount, h.lastStatus.FullInitCount, status.FullInitCount)
+	updateErrorValue(&currentItem.FailedFullInitCount, h.lastStatus.FailedFullInitCount, status.FailedFullInitCount)
+
+	updateErrorValue(&currentItem.RetrainCount, h.lastStatus.RetrainCount, status.RetrainCount)
+	updateErrorValue(&currentItem.FailedRetrainCount, h.lastStatus.FailedRetrainCount, status.FailedRetrainCount)
 
 	if h.shouldRejectValues(status) {
 		return
@@ -199,6 +224,15 @@ func (h *Errors) Update(status models.Status, now time.Time) {
 
 	updateErrorValue(&currentItem.DownstreamSESCount, h.lastStatus.DownstreamSESCount, status.DownstreamSESCount)
 	updateErrorValue(&currentItem.UpstreamSESCount, h.lastStatus.UpstreamSESCount, status.UpstreamSESCount)
+
+	updateErrorValue(&currentItem.DownstreamLOSSCount, h.lastStatus.DownstreamLOSSCount, status.DownstreamLOSSCount)
+	updateErrorValue(&currentItem.UpstreamLOSSCount, h.lastStatus.UpstreamLOSSCount, status.UpstreamLOSSCount)
+
+	updateErrorValue(&currentItem.DownstreamLOFSCount, h.lastStatus.DownstreamLOFSCount, status.DownstreamLOFSCount)
+	updateErrorValue(&currentItem.UpstreamLOFSCount, h.lastStatus.UpstreamLOFSCount, status.UpstreamLOFSCount)
+
+	updateErrorValue(&currentItem.DownstreamUASCount, h.lastStatus.DownstreamUASCount, status.DownstreamUASCount)
+	updateErrorValue(&currentItem.UpstreamUASCount, h.lastStatus.UpstreamUASCount, status.UpstreamUASCount)
 }
 
 func (h *Errors) Data() (out models.ErrorsHistory) {
@@ -229,6 +263,23 @@ func (h *Errors) Data() (out models.ErrorsHistory) {
 	out.DownstreamSESCount = make([]models.IntValue, h.config.PeriodCount, h.config.PeriodCount)
 	out.UpstreamSESCount = make([]models.IntValue, h.config.PeriodCount, h.config.PeriodCount)
 
+	out.DownstreamLOSSCount = make([]models.IntValue, h.config.PeriodCount, h.config.PeriodCount)
+	out.UpstreamLOSSCount = make([]models.IntValue, h.config.PeriodCount, h.config.PeriodCount)
+
+	out.DownstreamLOFSCount = make([]models.IntValue, h.config.PeriodCount, h.config.PeriodCount)
+	out.UpstreamLOFSCount = make([]models.IntValue, h.config.PeriodCount, h.config.PeriodCount)
+
+	out.DownstreamUASCount = make([]models.IntValue, h.config.PeriodCount, h.config.PeriodCount)
+	out.UpstreamUASCount = make([]models.IntValue, h.config.PeriodCount, h.config.PeriodCount)
+
+	out.FullInitCount = make([]models.IntValue, h.config.PeriodCount, h.config.PeriodCount)
+	out.FailedFullInitCount = make([]models.IntValue, h.config.PeriodCount, h.config.PeriodCount)
+
+	out.RetrainCount = make([]models.IntValue, h.config.PeriodCount, h.config.PeriodCount)
+	out.FailedRetrainCount = make([]models.IntValue, h.config.PeriodCount, h.config.PeriodCount)
+
+	out.LinkLossCount = make([]models.IntValue, h.config.PeriodCount, h.config.PeriodCount)
+
 	if len(h.data) != h.config.PeriodCount {
 		return
 	}
@@ -258,6 +309,23 @@ func (h *Errors) Data() (out models.ErrorsHistory) {
 
 		out.DownstreamSESCount[i] = h.data[index].DownstreamSESCount
 		out.UpstreamSESCount[i] = h.data[index].UpstreamSESCount
+
+		out.DownstreamLOSSCount[i] = h.data[index].DownstreamLOSSCount
+		out.UpstreamLOSSCount[i] = h.data[index].UpstreamLOSSCount
+
+		out.DownstreamLOFSCount[i] = h.data[index].DownstreamLOFSCount
+		out.UpstreamLOFSCount[i] = h.data[index].UpstreamLOFSCount
+
+		out.DownstreamUASCount[i] = h.data[index].DownstreamUASCount
+		out.UpstreamUASCount[i] = h.data[index].UpstreamUASCount
+
+		out.FullInitCount[i] = h.data[index].FullInitCount
+		out.FailedFullInitCount[i] = h.data[index].FailedFullInitCount
+
+		out.RetrainCount[i] = h.data[index].RetrainCount
+		out.FailedRetrainCount[i] = h.data[index].FailedRetrainCount
+
+		out.LinkLossCount[i] = h.data[index].LinkLossCount
 	}
 
 	return
@@ -14,7 +14,11 @@ import (
 	"3e8.eu/go/dsl/models"
 )
 
-const errorsStorageVersion = 1
+const errorsStorageVersion = 3
+
+// version 1 did not include the LOSS, LOFS and UAS counters, version 2 did not include the initialization,
+// retrain and link loss counters
+const errorsStorageVersionMin = 1
 
 type storageErrorsConfig struct {
 	PeriodLength int64
@@ -82,6 +86,36 @@ func (h *Errors) writeErrorsItem(w io.Writer, item errorsHistoryItem) error {
 		return err
 	}
 
+	err = h.writeErrorsIntValues(w, item.DownstreamLOSSCount, item.UpstreamLOSSCount)
+	if err != nil {
+		return err
+	}
+
+	err = h.writeErrorsIntValues(w, item.DownstreamLOFSCount, item.UpstreamLOFSCount)
+	if err != nil {
+		return err
+	}
+
+	err = h.writeErrorsIntValues(w, item.DownstreamUASCount, item.UpstreamUASCount)
+	if err != nil {
+		return err
+	}
+
+	err = h.writeErrorsIntValues(w, item.FullInitCount, item.FailedFullInitCount)
+	if err != nil {
+		return err
+	}
+
+	err = h.writeErrorsIntValues(w, item.RetrainCount, item.FailedRetrainCount)
+	if err != nil {
+		return err
+	}
+
+	err = h.writeErrorsIntValues(w, item.LinkLossCount)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -161,7 +195,7 @@ func (h *Errors) readErrorsIntValues(r io.Reader, values ...*models.IntValue) er
 	return nil
 }
 
-func (h *Errors) readErrorsItem(r io.Reader, item *errorsHistoryItem) error {
+func (h *Errors) readErrorsItem(r io.Reader, item *errorsHistoryItem, version uint32) error {
 	err := h.readErrorsBoolValue(r, &item.Showtime)
 	if err != nil {
 		return err
@@ -202,6 +236,44 @@ func (h *Errors) readErrorsItem(r io.Reader, item *errorsHistoryItem) error {
 		return err
 	}
 
+	if version < 2 {
+		return nil
+	}
+
+	err = h.readErrorsIntValues(r, &item.DownstreamLOSSCount, &item.UpstreamLOSSCount)
+	if err != nil {
+		return err
+	}
+
+	err = h.readErrorsIntValues(r, &item.DownstreamLOFSCount, &item.UpstreamLOFSCount)
+	if err != nil {
+		return err
+	}
+
+	err = h.readErrorsIntValues(r, &item.DownstreamUASCount, &item.UpstreamUASCount)
+	if err != nil {
+		return err
+	}
+
+	if version < 3 {
+		return nil
+	}
+
+	err = h.readErrorsIntValues(r, &item.FullInitCount, &item.FailedFullInitCount)
+	if err != nil {
+		return err
+	}
+
+	err = h.readErrorsIntValues(r, &item.RetrainCount, &item.FailedRetrainCount)
+	if err != nil {
+		return err
+	}
+
+	err = h.readErrorsIntValues(r, &item.LinkLossCount)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -215,7 +287,7 @@ func (h *Errors) Load(r io.Reader) error {
 		return fmt.Errorf("failed to read main header: %w", err)
 	}
 
-	if mainHeader.Version != errorsStorageVersion {
+	if mainHeader.Version < errorsStorageVersionMin || mainHeader.Version > errorsStorageVersion {
 		return fmt.Errorf("unsupported data version %d", mainHeader.Version)
 	}
 
@@ -266,7 +338,7 @@ func (h *Errors) Load(r io.Reader) error {
 	// Read data
 
 	for i := 0; i < config.PeriodCount; i++ {
-		err = newHistory.readErrorsItem(r, &newHistory.data[i])
+		err = newHistory.readErrorsItem(r, &newHistory.data[i], mainHeader.Version)
 		if err != nil {
 			return fmt.Errorf("failed to read errors item: %w", err)
 		}
@@ -0,0 +1,22 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package exec
+
+import (
+	"context"
+	"os/exec"
+	"time"
+)
+
+// LocalExecutor runs commands using the shell of the local machine.
+type LocalExecutor struct{}
+
+func (e *LocalExecutor) Execute(cmd string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, "/bin/sh", "-c", cmd).CombinedOutput()
+	return string(output), err
+}
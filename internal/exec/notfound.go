@@ -6,6 +6,7 @@ package exec
 
 import (
 	"errors"
+	"os/exec"
 	"regexp"
 
 	"golang.org/x/crypto/ssh"
@@ -22,6 +23,13 @@ func IsCommandNotFound(output string, err error) bool {
 				return true
 			}
 		}
+
+		var localExitErr *exec.ExitError
+		if errors.As(err, &localExitErr) {
+			if localExitErr.ExitCode() == 127 {
+				return true
+			}
+		}
 	}
 
 	truncatedOutput := output
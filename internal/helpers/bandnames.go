@@ -0,0 +1,28 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package helpers
+
+import (
+	"strconv"
+
+	"3e8.eu/go/dsl/models"
+)
+
+// BandName returns the name of the band with the given index (such as U0, U1 or D1) for VDSL2. The
+// upstream band U0 is only assumed to be used if the first upstream band is below the first
+// downstream band.
+func BandName(bands models.BandsDownUp, upstream bool, index int) string {
+	if !upstream {
+		return "D" + strconv.Itoa(index+1)
+	}
+
+	if len(bands.Upstream) != 0 && len(bands.Downstream) != 0 &&
+		bands.Upstream[0].Start > bands.Downstream[0].Start {
+
+		index++
+	}
+
+	return "U" + strconv.Itoa(index)
+}
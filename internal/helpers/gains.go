@@ -0,0 +1,21 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package helpers
+
+import (
+	"math"
+)
+
+// ConvertGain converts a linear fine gain value in steps of 1/512, as reported according to G.997.1, to dB.
+// Unused subcarriers (with a gain of zero) are reported as -14.6, which is below the valid range.
+func ConvertGain(val int64) float64 {
+	if val <= 0 {
+		return -14.6
+	}
+
+	gain := 20 * math.Log10(float64(val)/512)
+
+	return math.Max(-14.5, math.Min(2.5, gain))
+}
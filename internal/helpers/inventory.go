@@ -0,0 +1,88 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"3e8.eu/go/dsl/models"
+)
+
+// country codes according to ITU-T T.35
+var countryMapping = map[byte]string{
+	0x00: "Japan",
+	0x04: "Germany",
+	0x26: "China",
+	0x3D: "France",
+	0x59: "Italy",
+	0xB4: "United Kingdom",
+	0xB5: "United States",
+}
+
+// chipset platforms of Infineon/Lantiq firmware versions, as seen on the far end (only those that are known for
+// sure, see also isCarrierOffice in the lantiq package)
+var modelMappingInfineon = map[byte]string{
+	9: "VINAX",
+}
+
+func FormatCountry(code []byte) string {
+	if len(code) != 2 || (code[0] == 0 && code[1] == 0) {
+		return ""
+	}
+
+	if country, ok := countryMapping[code[0]]; ok {
+		return country
+	}
+
+	return fmt.Sprintf("0x%02X%02X", code[0], code[1])
+}
+
+func FormatModel(vendor string, version []byte) string {
+	if len(version) != 2 || (version[0] == 0 && version[1] == 0) {
+		return ""
+	}
+
+	if vendor == "Infineon" {
+		return modelMappingInfineon[version[0]>>4]
+	}
+
+	return ""
+}
+
+// FormatInventoryString converts a fixed-length string field to a string, removing padding.
+func FormatInventoryString(data []byte) string {
+	var b strings.Builder
+	for _, c := range data {
+		if c >= 0x20 && c < 0x7f {
+			b.WriteByte(c)
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// InterpretVendorID sets vendor, version, country and model of the inventory from a G.994.1 vendor ID.
+func InterpretVendorID(inventory *models.Inventory, vendorID []byte) {
+	if len(vendorID) != 8 {
+		return
+	}
+
+	inventory.Vendor = FormatVendor(string(vendorID[2:6]))
+	inventory.Version = FormatVersion(inventory.Vendor, vendorID[6:8])
+	inventory.Country = FormatCountry(vendorID[0:2])
+	inventory.Model = FormatModel(inventory.Vendor, vendorID[6:8])
+}
+
+// InterpretSystemVendorID sets the system vendor of the inventory from a G.997.1 system vendor ID.
+func InterpretSystemVendorID(inventory *models.Inventory, systemVendorID []byte) {
+	if len(systemVendorID) != 8 {
+		return
+	}
+
+	inventory.SystemVendor = FormatVendor(FormatInventoryString(systemVendorID[2:6]))
+	if inventory.Country == "" {
+		inventory.Country = FormatCountry(systemVendorID[0:2])
+	}
+}
@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package helpers
+
+import (
+	"sort"
+
+	"3e8.eu/go/dsl/models"
+)
+
+// PSDBreakpoint is a single point of a PSD mask, the PSD in dBm/Hz is interpolated linearly between breakpoints.
+type PSDBreakpoint struct {
+	Bin int
+	PSD float64
+}
+
+// GeneratePSDData converts the breakpoints of a PSD mask to per-bin data. If bands are given, the data is limited
+// to the bins within these bands. Bins without data are set to zero.
+func GeneratePSDData(out *models.BinsFloat, binCount int, bands []models.Band, breakpoints []PSDBreakpoint) {
+	if len(breakpoints) == 0 || binCount == 0 {
+		return
+	}
+
+	sort.SliceStable(breakpoints, func(i, j int) bool {
+		return breakpoints[i].Bin < breakpoints[j].Bin
+	})
+
+	out.GroupSize = 1
+	out.Data = make([]float64, binCount)
+
+	setValue := func(bin int, val float64) {
+		if bin < 0 || bin >= binCount {
+			return
+		}
+		if len(bands) != 0 && !isInBands(bin, bands) {
+			return
+		}
+		out.Data[bin] = val
+	}
+
+	if len(breakpoints) == 1 {
+		setValue(breakpoints[0].Bin, breakpoints[0].PSD)
+		return
+	}
+
+	for i := 1; i < len(breakpoints); i++ {
+		start := breakpoints[i-1]
+		end := breakpoints[i]
+
+		if start.Bin == end.Bin {
+			setValue(end.Bin, end.PSD)
+			continue
+		}
+
+		for bin := start.Bin; bin <= end.Bin; bin++ {
+			ratio := float64(bin-start.Bin) / float64(end.Bin-start.Bin)
+			setValue(bin, start.PSD+ratio*(end.PSD-start.PSD))
+		}
+	}
+}
+
+func isInBands(bin int, bands []models.Band) bool {
+	for _, band := range bands {
+		if bin >= band.Start && bin <= band.End {
+			return true
+		}
+	}
+	return false
+}
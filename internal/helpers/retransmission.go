@@ -0,0 +1,35 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package helpers
+
+import (
+	"3e8.eu/go/dsl/models"
+)
+
+// CompleteRetransmissionStatus finalizes the retransmission parameters of both directions, it needs to be called
+// after the status has been parsed completely.
+func CompleteRetransmissionStatus(status *models.Status) {
+	completeRetransmissionStatus(&status.DownstreamRetransmission,
+		status.DownstreamRetransmissionEnabled, status.DownstreamImpulseNoiseProtection)
+	completeRetransmissionStatus(&status.UpstreamRetransmission,
+		status.UpstreamRetransmissionEnabled, status.UpstreamImpulseNoiseProtection)
+}
+
+func completeRetransmissionStatus(out *models.RetransmissionStatus, enabled models.BoolValue, inp models.ValueSymbols) {
+	if !enabled.Valid {
+		return
+	}
+
+	// the parameters are meaningless without retransmission, e.g. the net data rate is just the actual rate
+	if !enabled.Bool {
+		*out = models.RetransmissionStatus{}
+		return
+	}
+
+	// according to G.997.1, the actual INP (ACTINP) is reported as INP_act_SHINE if retransmission is used
+	if !out.ImpulseNoiseProtectionSHINE.Valid {
+		out.ImpulseNoiseProtectionSHINE = inp
+	}
+}
@@ -15,6 +15,9 @@ import (
 	"3e8.eu/go/dsl/internal/helpers"
 )
 
+// DefaultCommunity is used for SNMPv1/v2c if no community string is given
+const DefaultCommunity = "public"
+
 type Client struct {
 	client *gosnmp.GoSNMP
 }
@@ -34,18 +37,68 @@ func NewClient(host, transport, username string,
 	return &c, nil
 }
 
+func NewClientCommunity(host, transport string, version Version, community string) (*Client, error) {
+	c := Client{}
+
+	err := c.setupCommunity(host, transport, version, community)
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (c *Client) setupCommunity(host, transport string, version Version, community string) error {
+	snmpVersion, err := version.version()
+	if err != nil {
+		return err
+	}
+	if snmpVersion == gosnmp.Version3 {
+		return errors.New("community-based access is not supported for SNMPv3")
+	}
+
+	host, port, err := splitHostPort(host)
+	if err != nil {
+		return err
+	}
+
+	if community == "" {
+		community = DefaultCommunity
+	}
+
+	c.client = &gosnmp.GoSNMP{
+		Target:    host,
+		Port:      port,
+		Transport: transport,
+		Version:   snmpVersion,
+		Community: community,
+		Timeout:   5 * time.Second,
+		Retries:   2,
+	}
+
+	return c.client.Connect()
+}
+
+func splitHostPort(hostport string) (host string, port uint16, err error) {
+	host, port, err = helpers.SplitHostPort(hostport)
+	if err != nil {
+		return
+	}
+	if port == 0 {
+		port = 161
+	}
+	return
+}
+
 func (c *Client) setup(host, transport, username string,
 	authProtocol AuthProtocol, privacyProtocol PrivacyProtocol,
 	passwordCallback dsl.PasswordCallback,
 	encryptionPassphraseCallback dsl.EncryptionPassphraseCallback) error {
 
-	host, port, err := helpers.SplitHostPort(host)
+	host, port, err := splitHostPort(host)
 	if err != nil {
 		return err
 	}
-	if port == 0 {
-		port = 161
-	}
 
 	securityParams := &gosnmp.UsmSecurityParameters{
 		UserName: username,
@@ -138,7 +191,27 @@ func (c *Client) Walk(oid string) (Values, error) {
 	var v Values
 	v.init()
 
-	err := c.client.BulkWalk(oid, func(pdu gosnmp.SnmpPDU) error {
+	err := c.walk(&v, oid)
+
+	return v, err
+}
+
+func (c *Client) WalkMultiple(oids []string) (Values, error) {
+	var v Values
+	v.init()
+
+	for _, oid := range oids {
+		err := c.walk(&v, oid)
+		if err != nil {
+			return v, err
+		}
+	}
+
+	return v, nil
+}
+
+func (c *Client) walk(v *Values, oid string) error {
+	walkFunc := func(pdu gosnmp.SnmpPDU) error {
 		v.add(Value{
 			OID:  pdu.Name,
 			Type: byte(pdu.Type),
@@ -146,9 +219,13 @@ func (c *Client) Walk(oid string) (Values, error) {
 		})
 
 		return nil
-	})
+	}
 
-	return v, err
+	// bulk requests are not available in SNMPv1
+	if c.client.Version == gosnmp.Version1 {
+		return c.client.Walk(oid, walkFunc)
+	}
+	return c.client.BulkWalk(oid, walkFunc)
 }
 
 func (c *Client) Close() error {
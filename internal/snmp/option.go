@@ -47,3 +47,23 @@ func GetPrivacyProtocolOption(protos ...PrivacyProtocol) dsl.Option {
 
 	return opt
 }
+
+func GetVersionOption(versions ...Version) dsl.Option {
+	opt := dsl.Option{
+		Description: "SNMP version",
+		Type:        dsl.OptionTypeEnum,
+		Values:      []dsl.OptionValue{},
+	}
+
+	for _, version := range versions {
+		title, err := version.desc()
+		if err != nil {
+			panic(err)
+		}
+
+		val := dsl.OptionValue{Value: string(version), Title: title}
+		opt.Values = append(opt.Values, val)
+	}
+
+	return opt
+}
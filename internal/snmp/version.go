@@ -0,0 +1,45 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package snmp
+
+import (
+	"errors"
+
+	"github.com/gosnmp/gosnmp"
+)
+
+type Version string
+
+const (
+	Version1  = "1"
+	Version2c = "2c"
+	Version3  = "3"
+)
+
+func (v Version) version() (gosnmp.SnmpVersion, error) {
+	switch v {
+	case Version1:
+		return gosnmp.Version1, nil
+	case Version2c:
+		return gosnmp.Version2c, nil
+	case Version3:
+		return gosnmp.Version3, nil
+	default:
+		return gosnmp.Version3, errors.New("invalid SNMP version")
+	}
+}
+
+func (v Version) desc() (string, error) {
+	switch v {
+	case Version1:
+		return "SNMPv1", nil
+	case Version2c:
+		return "SNMPv2c", nil
+	case Version3:
+		return "SNMPv3", nil
+	default:
+		return "", errors.New("invalid SNMP version")
+	}
+}
@@ -5,14 +5,15 @@
 package ssh
 
 import (
-	"bufio"
 	"errors"
+	"fmt"
 	"net"
 	"regexp"
 	"strings"
 	"time"
 
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
 	"golang.org/x/crypto/ssh/knownhosts"
 
 	"3e8.eu/go/dsl"
@@ -21,18 +22,22 @@ import (
 var regexpPort = regexp.MustCompile(`:[0-9]+$`)
 
 type Client struct {
-	conn   net.Conn
-	client *ssh.Client
+	conn       net.Conn
+	client     *ssh.Client
+	jumpClient *ssh.Client
 }
 
 func NewClient(host, username string,
 	password dsl.PasswordCallback,
 	privateKeys dsl.PrivateKeysCallback,
-	knownHosts string) (*Client, error) {
+	sshAgent bool,
+	knownHosts string,
+	unknownHostKey dsl.UnknownHostKeyCallback,
+	jumpHost *dsl.JumpHostConfig) (*Client, error) {
 
 	c := Client{}
 
-	err := c.connect(host, username, password, privateKeys, knownHosts)
+	err := c.connect(host, username, password, privateKeys, sshAgent, knownHosts, unknownHostKey, jumpHost)
 	if err != nil {
 		return nil, err
 	}
@@ -40,21 +45,39 @@ func NewClient(host, username string,
 	return &c, nil
 }
 
-func (c *Client) connect(host, username string,
-	passwordCallback dsl.PasswordCallback,
-	privateKeysCallback dsl.PrivateKeysCallback,
-	knownHosts string) error {
-
+func normalizeHost(host string) string {
 	if !regexpPort.MatchString(host) {
 		host += ":22"
 	}
+	return host
+}
+
+func newClientConfig(host, username string,
+	passwordCallback dsl.PasswordCallback,
+	privateKeysCallback dsl.PrivateKeysCallback,
+	agentClient agent.Agent,
+	knownHosts string,
+	unknownHostKey dsl.UnknownHostKeyCallback) (*ssh.ClientConfig, error) {
 
 	config := &ssh.ClientConfig{User: username}
 
-	if privateKeysCallback.Keys != nil {
+	// all keys need to be provided by a single callback, as each authentication method is only tried once
+	if privateKeysCallback.Keys != nil || agentClient != nil {
 		config.Auth = append(config.Auth, ssh.PublicKeysCallback(func() ([]ssh.Signer, error) {
 			signers := make([]ssh.Signer, 0)
 
+			if agentClient != nil {
+				agentSigners, err := agentClient.Signers()
+				if err != nil {
+					return nil, &dsl.AuthenticationError{Err: fmt.Errorf("SSH agent: %w", err)}
+				}
+				signers = append(signers, agentSigners...)
+			}
+
+			if privateKeysCallback.Keys == nil {
+				return signers, nil
+			}
+
 			privateKeys, err := privateKeysCallback.Keys()
 			if err != nil {
 				return nil, &dsl.AuthenticationError{Err: err}
@@ -99,65 +122,164 @@ func (c *Client) connect(host, username string,
 			}
 			return password, nil
 		}))
+
+		// some devices only support keyboard-interactive authentication, assume that the password is requested
+		config.Auth = append(config.Auth, ssh.KeyboardInteractive(func(name, instruction string, questions []string, echos []bool) ([]string, error) {
+			answers := make([]string, len(questions))
+			if len(questions) == 0 {
+				return answers, nil
+			}
+
+			password, err := passwordCallback()
+			if err != nil {
+				return nil, &dsl.AuthenticationError{Err: err}
+			}
+
+			for i := range questions {
+				if !echos[i] {
+					answers[i] = password
+				}
+			}
+
+			return answers, nil
+		}))
 	}
 
-	if knownHosts == "" {
-		return errors.New("missing SSH host key")
-	} else if knownHosts == "IGNORE" {
+	if knownHosts == "IGNORE" {
 		config.HostKeyCallback = ssh.InsecureIgnoreHostKey()
-	} else {
-		var hostKey ssh.PublicKey
-
-		hostNormalized := knownhosts.Normalize(host)
+		return config, nil
+	}
 
-		scanner := bufio.NewScanner(strings.NewReader(knownHosts))
-		for scanner.Scan() {
-			line := scanner.Text()
-			line = strings.TrimSpace(line)
+	hostKey, err := findHostKey(knownHosts, host)
+	if err != nil {
+		return nil, err
+	}
 
-			if len(line) == 0 {
-				continue
-			}
+	if hostKey != nil {
+		config.HostKeyAlgorithms = []string{hostKey.Type()}
+		config.HostKeyCallback = ssh.FixedHostKey(hostKey)
+	} else if unknownHostKey != nil {
+		config.HostKeyCallback = func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			fingerprint := ssh.FingerprintSHA256(key)
+			line := knownhosts.Line([]string{host}, key)
 
-			_, hosts, key, _, _, err := ssh.ParseKnownHosts([]byte(line))
+			err := unknownHostKey(host, fingerprint, line)
 			if err != nil {
-				return err
+				return fmt.Errorf("unknown SSH host key %s: %w", fingerprint, err)
 			}
 
-			for _, h := range hosts {
-				if h == hostNormalized {
-					hostKey = key
-					break
-				} else if strings.HasPrefix(h, "|1|") && checkHashedHost(h, hostNormalized) {
-					hostKey = key
-					break
-				}
-			}
+			return nil
 		}
+	} else if knownHosts == "" {
+		return nil, errors.New("missing SSH host key")
+	} else {
+		return nil, errors.New("no matching SSH host key found")
+	}
 
-		if hostKey == nil {
-			return errors.New("no matching SSH host key found")
+	return config, nil
+}
+
+func newClientConn(conn net.Conn, host string, config *ssh.ClientConfig) (*ssh.Client, error) {
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, host, config)
+	if err != nil {
+		if strings.Contains(err.Error(), "unable to authenticate") {
+			return nil, &dsl.AuthenticationError{Err: err}
 		}
+		return nil, err
+	}
 
-		config.HostKeyAlgorithms = []string{hostKey.Type()}
-		config.HostKeyCallback = ssh.FixedHostKey(hostKey)
+	return ssh.NewClient(sshConn, chans, reqs), nil
+}
+
+func (c *Client) connect(host, username string,
+	passwordCallback dsl.PasswordCallback,
+	privateKeysCallback dsl.PrivateKeysCallback,
+	sshAgent bool,
+	knownHosts string,
+	unknownHostKey dsl.UnknownHostKeyCallback,
+	jumpHost *dsl.JumpHostConfig) error {
+
+	host = normalizeHost(host)
+
+	var agentClient agent.Agent
+	if sshAgent || (jumpHost != nil && jumpHost.AuthSSHAgent) {
+		agentConn, err := connectAgent()
+		if err != nil {
+			return &dsl.AuthenticationError{Err: err}
+		}
+		defer agentConn.Close()
+
+		agentClient = agent.NewClient(agentConn)
 	}
 
-	tcpConn, err := net.DialTimeout("tcp", host, 10*time.Second)
+	var configAgentClient agent.Agent
+	if sshAgent {
+		configAgentClient = agentClient
+	}
+
+	config, err := newClientConfig(host, username, passwordCallback, privateKeysCallback, configAgentClient, knownHosts, unknownHostKey)
 	if err != nil {
 		return err
 	}
 
-	sshConn, chans, reqs, err := ssh.NewClientConn(tcpConn, host, config)
-	if err != nil {
-		if strings.Contains(err.Error(), "unable to authenticate") {
-			return &dsl.AuthenticationError{Err: err}
+	if jumpHost == nil {
+		tcpConn, err := net.DialTimeout("tcp", host, 10*time.Second)
+		if err != nil {
+			return err
 		}
+
+		c.client, err = newClientConn(tcpConn, host, config)
+		if err != nil {
+			tcpConn.Close()
+			return err
+		}
+
+		c.conn = tcpConn
+
+		return nil
+	}
+
+	jumpHostAddr := normalizeHost(jumpHost.Host)
+
+	var jumpAgentClient agent.Agent
+	if jumpHost.AuthSSHAgent {
+		jumpAgentClient = agentClient
+	}
+
+	jumpConfig, err := newClientConfig(jumpHostAddr, jumpHost.User,
+		jumpHost.AuthPassword, jumpHost.AuthPrivateKeys, jumpAgentClient, jumpHost.KnownHosts, jumpHost.UnknownHostKey)
+	if err != nil {
+		return fmt.Errorf("jump host: %w", err)
+	}
+
+	tcpConn, err := net.DialTimeout("tcp", jumpHostAddr, 10*time.Second)
+	if err != nil {
+		return fmt.Errorf("jump host: %w", err)
+	}
+
+	c.jumpClient, err = newClientConn(tcpConn, jumpHostAddr, jumpConfig)
+	if err != nil {
+		tcpConn.Close()
+		return fmt.Errorf("jump host: %w", err)
+	}
+
+	// the forwarded connection does not support deadlines, so they are set on the connection to
+	// the jump host instead (only while dialing, as the following handshake may wait for user input)
+	tcpConn.SetDeadline(time.Now().Add(10 * time.Second))
+	forwardedConn, err := c.jumpClient.Dial("tcp", host)
+	tcpConn.SetDeadline(time.Time{})
+	if err != nil {
+		c.jumpClient.Close()
+		return err
+	}
+
+	c.client, err = newClientConn(forwardedConn, host, config)
+	if err != nil {
+		c.jumpClient.Close()
 		return err
 	}
 
 	c.conn = tcpConn
-	c.client = ssh.NewClient(sshConn, chans, reqs)
 
 	return nil
 }
@@ -189,5 +311,9 @@ func (c *Client) Execute(command string) (string, error) {
 }
 
 func (c *Client) Close() error {
-	return c.client.Close()
+	err := c.client.Close()
+	if c.jumpClient != nil {
+		c.jumpClient.Close()
+	}
+	return err
 }
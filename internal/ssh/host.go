@@ -5,12 +5,49 @@
 package ssh
 
 import (
+	"bufio"
 	"crypto/hmac"
 	"crypto/sha1"
 	"encoding/base64"
 	"strings"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
 )
 
+func findHostKey(knownHosts, host string) (ssh.PublicKey, error) {
+	var hostKey ssh.PublicKey
+
+	hostNormalized := knownhosts.Normalize(host)
+
+	scanner := bufio.NewScanner(strings.NewReader(knownHosts))
+	for scanner.Scan() {
+		line := scanner.Text()
+		line = strings.TrimSpace(line)
+
+		if len(line) == 0 {
+			continue
+		}
+
+		_, hosts, key, _, _, err := ssh.ParseKnownHosts([]byte(line))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, h := range hosts {
+			if h == hostNormalized {
+				hostKey = key
+				break
+			} else if strings.HasPrefix(h, "|1|") && checkHashedHost(h, hostNormalized) {
+				hostKey = key
+				break
+			}
+		}
+	}
+
+	return hostKey, nil
+}
+
 func checkHashedHost(hash, host string) bool {
 	split := strings.Split(hash, "|")
 	if len(split) != 4 {
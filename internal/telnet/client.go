@@ -6,6 +6,7 @@ package telnet
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"regexp"
 	"strings"
@@ -21,16 +22,25 @@ var regexpPort = regexp.MustCompile(`:[0-9]+$`)
 // see ECMA-48, sections 5.3 and 5.4
 var regexpANSIEscapeSequence = regexp.MustCompile("\x1b" + `(?:[@-Z\\-_]|\[[0-?]*[ -/]*[@-~])`)
 
+type conn interface {
+	ReadUntilIndex(delims ...string) ([]byte, int, error)
+	Write(b []byte) (int, error)
+	SetDeadline(t time.Time) error
+	Close() error
+}
+
 type Client struct {
 	config          ClientConfig
-	conn            *telnet.Conn
+	prompts         []Prompts
+	conn            conn
 	lastWrittenLine string
 	lastPromptLine  string
 }
 
 func NewClient(config ClientConfig, host, username string, password dsl.PasswordCallback) (*Client, error) {
 	c := Client{
-		config: config,
+		config:  config,
+		prompts: append([]Prompts{}, config.Prompts...),
 	}
 
 	err := c.connect(host, username, password)
@@ -43,7 +53,7 @@ func NewClient(config ClientConfig, host, username string, password dsl.Password
 
 func (c *Client) writeLine(data string, isSensitive bool) error {
 	if strings.ContainsAny(data, "\r\n") {
-		return errors.New("only input without newline character is supported for Telnet")
+		return errors.New("only input without newline character is supported")
 	}
 
 	if !isSensitive {
@@ -136,6 +146,20 @@ func (c *Client) readUntilPrompt(prompts ...string) (data string, promptType pro
 }
 
 func (c *Client) connect(host, username string, passwordCallback dsl.PasswordCallback) error {
+	if c.config.Serial {
+		serial, err := openSerial(host, c.config.BaudRate)
+		if err != nil {
+			return err
+		}
+		c.conn = serial
+
+		err = c.login(username, passwordCallback)
+		if err != nil {
+			c.conn.Close()
+		}
+		return err
+	}
+
 	if !regexpPort.MatchString(host) {
 		host += ":23"
 	}
@@ -146,14 +170,26 @@ func (c *Client) connect(host, username string, passwordCallback dsl.PasswordCal
 		return err
 	}
 
+	return c.login(username, passwordCallback)
+}
+
+func (c *Client) login(username string, passwordCallback dsl.PasswordCallback) error {
 	triedUsername := false
 	triedPassword := false
 
-	err = c.conn.SetDeadline(time.Now().Add(10 * time.Second))
+	err := c.conn.SetDeadline(time.Now().Add(10 * time.Second))
 	if err != nil {
 		return err
 	}
 
+	// A serial console only prints a prompt after receiving some input
+	if c.config.Serial {
+		err = c.writeLine("", false)
+		if err != nil {
+			return err
+		}
+	}
+
 	for {
 		prompts := c.getPromptList(promptTypeAccount | promptTypePassword | promptTypeCommand)
 		_, prompt, err := c.readUntilPrompt(prompts...)
@@ -202,12 +238,67 @@ func (c *Client) connect(host, username string, passwordCallback dsl.PasswordCal
 			}
 
 		case promptTypeCommand:
-			return nil
+			return c.runPreCommands(passwordCallback)
 
 		}
 	}
 }
 
+func (c *Client) runPreCommands(passwordCallback dsl.PasswordCallback) error {
+	for _, command := range c.config.PreCommands {
+		err := c.conn.SetDeadline(time.Now().Add(10 * time.Second))
+		if err != nil {
+			return err
+		}
+
+		err = c.writeLine(command, false)
+		if err != nil {
+			return err
+		}
+
+		// the prompt may change (e.g. when starting a shell), so all configured prompts are accepted again
+		c.config.Prompts = append([]Prompts{}, c.prompts...)
+
+		triedPassword := false
+
+		for {
+			prompts := c.getPromptList(promptTypePassword | promptTypeCommand)
+			_, prompt, err := c.readUntilPrompt(prompts...)
+			if err != nil {
+				if errors.Is(err, os.ErrDeadlineExceeded) {
+					return fmt.Errorf("no prompt detected after command %s", command)
+				}
+				return err
+			}
+
+			if prompt == promptTypeCommand {
+				break
+			}
+
+			// some commands (such as enable) may ask for the password again
+			if triedPassword {
+				return &dsl.AuthenticationError{Err: fmt.Errorf("invalid password for command %s", command)}
+			}
+			triedPassword = true
+
+			var password string
+			if passwordCallback != nil {
+				password, err = passwordCallback()
+				if err != nil {
+					return &dsl.AuthenticationError{Err: err}
+				}
+			}
+
+			err = c.writeLine(password, true)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func (c *Client) Execute(command string) (string, error) {
 	err := c.conn.SetDeadline(time.Now().Add(30 * time.Second))
 	if err != nil {
@@ -13,5 +13,35 @@ type Prompts struct {
 type ClientConfig struct {
 	Prompts []Prompts
 
+	// PreCommands are executed after login, e.g. to switch from a menu to a shell
+	PreCommands []string
+
 	ExpectRepeatedPromptCRLF bool
+
+	// Serial specifies to use the serial console at the device path given as host
+	Serial   bool
+	BaudRate int
+}
+
+// WithCustomPrompts returns the prompts with an additional set of user-supplied prompts at the start.
+// Missing values of the custom set are taken from the first of the given prompts.
+func WithCustomPrompts(prompts []Prompts, account, password, command string) []Prompts {
+	if account == "" && password == "" && command == "" {
+		return prompts
+	}
+
+	custom := Prompts{Account: account, Password: password, Command: command}
+	if len(prompts) != 0 {
+		if custom.Account == "" {
+			custom.Account = prompts[0].Account
+		}
+		if custom.Password == "" {
+			custom.Password = prompts[0].Password
+		}
+		if custom.Command == "" {
+			custom.Command = prompts[0].Command
+		}
+	}
+
+	return append([]Prompts{custom}, prompts...)
 }
@@ -0,0 +1,81 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package telnet
+
+import (
+	"strconv"
+	"strings"
+
+	"3e8.eu/go/dsl"
+)
+
+func GetSerialOption() dsl.Option {
+	return dsl.Option{
+		Description: "connect via serial console instead, the host is the path of the serial device (e.g. /dev/ttyUSB0)",
+		Type:        dsl.OptionTypeBool,
+	}
+}
+
+func GetBaudRateOption() dsl.Option {
+	opt := dsl.Option{
+		Description: "baud rate of the serial console",
+		Type:        dsl.OptionTypeEnum,
+		Values:      []dsl.OptionValue{},
+	}
+
+	for _, baudRate := range []int{115200, 57600, 38400, 19200, 9600} {
+		str := strconv.Itoa(baudRate)
+		opt.Values = append(opt.Values, dsl.OptionValue{Value: str, Title: str})
+	}
+
+	return opt
+}
+
+func ParseBaudRate(str string) int {
+	baudRate, err := strconv.Atoi(str)
+	if err != nil {
+		return 0
+	}
+	return baudRate
+}
+
+func GetAccountPromptOption() dsl.Option {
+	return dsl.Option{
+		Description: "custom login prompt, if not detected automatically (e.g. \"Username: \")",
+		Type:        dsl.OptionTypeString,
+	}
+}
+
+func GetPasswordPromptOption() dsl.Option {
+	return dsl.Option{
+		Description: "custom password prompt, if not detected automatically (e.g. \"Password: \")",
+		Type:        dsl.OptionTypeString,
+	}
+}
+
+func GetCommandPromptOption() dsl.Option {
+	return dsl.Option{
+		Description: "custom command prompt, if not detected automatically (e.g. \"# \")",
+		Type:        dsl.OptionTypeString,
+	}
+}
+
+func GetPreCommandsOption() dsl.Option {
+	return dsl.Option{
+		Description: "commands to run after login, separated by semicolons (e.g. \"enable;sh\")",
+		Type:        dsl.OptionTypeString,
+	}
+}
+
+func ParsePreCommands(str string) []string {
+	var commands []string
+	for _, command := range strings.Split(str, ";") {
+		command = strings.TrimSpace(command)
+		if command != "" {
+			commands = append(commands, command)
+		}
+	}
+	return commands
+}
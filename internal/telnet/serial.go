@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package telnet
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"time"
+)
+
+const defaultBaudRate = 115200
+
+type serialConn struct {
+	file   *os.File
+	reader *bufio.Reader
+}
+
+func (c *serialConn) ReadUntilIndex(delims ...string) ([]byte, int, error) {
+	var data []byte
+
+	delimsBytes := make([][]byte, len(delims))
+	for i, delim := range delims {
+		delimsBytes[i] = []byte(delim)
+	}
+
+	for {
+		b, err := c.reader.ReadByte()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// NUL bytes may be received when the device is powered on or reset
+		if b == 0 {
+			continue
+		}
+
+		data = append(data, b)
+
+		for i, delim := range delimsBytes {
+			if len(delim) != 0 && bytes.HasSuffix(data, delim) {
+				return data, i, nil
+			}
+		}
+	}
+}
+
+func (c *serialConn) Write(b []byte) (int, error) {
+	return c.file.Write(b)
+}
+
+func (c *serialConn) SetDeadline(t time.Time) error {
+	return c.file.SetDeadline(t)
+}
+
+func (c *serialConn) Close() error {
+	return c.file.Close()
+}
@@ -0,0 +1,162 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build linux
+
+package telnet
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"golang.org/x/sys/unix"
+
+	"3e8.eu/go/dsl"
+)
+
+var testPrompts = []Prompts{
+	{Account: "login: ", Password: "Password: ", Command: "# "},
+}
+
+// openPty opens a pseudo-terminal pair and returns the master side and the path of the slave device.
+func openPty(t *testing.T) (*os.File, string) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		t.Skipf("pseudo-terminals not available: %v", err)
+	}
+	t.Cleanup(func() { master.Close() })
+
+	var ptyNumber uint32
+	var controlErr error
+	rawConn, err := master.SyscallConn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rawConn.Control(func(fd uintptr) {
+		controlErr = unix.IoctlSetPointerInt(int(fd), unix.TIOCSPTLCK, 0)
+		if controlErr == nil {
+			ptyNumber, controlErr = unix.IoctlGetUint32(int(fd), unix.TIOCGPTN)
+		}
+	})
+	if err == nil {
+		err = controlErr
+	}
+	if err != nil {
+		t.Fatalf("failed to set up pseudo-terminal: %v", err)
+	}
+
+	return master, fmt.Sprintf("/dev/pts/%d", ptyNumber)
+}
+
+// serveLogin emulates the serial console of a device on the master side of the pseudo-terminal. The received
+// lines are sent to the returned channel, which is closed once the session ends.
+func serveLogin(master *os.File, password string) <-chan string {
+	lines := make(chan string, 8)
+
+	go func() {
+		defer close(lines)
+
+		reader := bufio.NewReader(master)
+		readLine := func() (string, bool) {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return "", false
+			}
+			line = strings.TrimRight(line, "\r\n")
+			lines <- line
+			return line, true
+		}
+
+		// the console only prints the prompt after receiving some input
+		if _, ok := readLine(); !ok {
+			return
+		}
+
+		for {
+			master.WriteString("\r\nlogin: ")
+			username, ok := readLine()
+			if !ok {
+				return
+			}
+			master.WriteString(username + "\r\n")
+
+			master.WriteString("Password: ")
+			receivedPassword, ok := readLine()
+			if !ok {
+				return
+			}
+			master.WriteString("\r\n")
+
+			if receivedPassword == password {
+				master.WriteString("\r\nBusyBox built-in shell (ash)\r\n\r\n# ")
+				return
+			}
+
+			master.WriteString("Login incorrect\r\n")
+		}
+	}()
+
+	return lines
+}
+
+func newSerialTestClient(t *testing.T, device string) *Client {
+	serial, err := openSerial(device, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { serial.Close() })
+
+	return &Client{
+		config: ClientConfig{
+			Prompts: append([]Prompts{}, testPrompts...),
+			Serial:  true,
+		},
+		prompts: append([]Prompts{}, testPrompts...),
+		conn:    serial,
+	}
+}
+
+func TestSerialLogin(t *testing.T) {
+	master, device := openPty(t)
+	lines := serveLogin(master, "secret")
+
+	c := newSerialTestClient(t, device)
+
+	err := c.login("root", func() (string, error) { return "secret", nil })
+	if err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+
+	var received []string
+	for line := range lines {
+		received = append(received, line)
+	}
+
+	expected := []string{"", "root", "secret"}
+	if strings.Join(received, "|") != strings.Join(expected, "|") {
+		t.Errorf("unexpected input sent to device: %q, expected %q", received, expected)
+	}
+
+	if c.lastPromptLine != "# " {
+		t.Errorf("unexpected prompt line: %q", c.lastPromptLine)
+	}
+}
+
+func TestSerialLoginInvalidPassword(t *testing.T) {
+	master, device := openPty(t)
+	serveLogin(master, "secret")
+
+	c := newSerialTestClient(t, device)
+
+	err := c.login("root", func() (string, error) { return "wrong", nil })
+
+	var authErr *dsl.AuthenticationError
+	if !errors.As(err, &authErr) {
+		t.Fatalf("expected authentication error, got: %v", err)
+	}
+}
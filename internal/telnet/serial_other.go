@@ -0,0 +1,15 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !linux
+
+package telnet
+
+import (
+	"errors"
+)
+
+func openSerial(device string, baudRate int) (*serialConn, error) {
+	return nil, errors.New("serial connections are not supported on this platform")
+}
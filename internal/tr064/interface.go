@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package tr064
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+
+	"3e8.eu/go/dsl/models"
+)
+
+// InterfaceConfigInfo is the response of WANDSLInterfaceConfig#GetInfo. All values are read as strings, as
+// devices may leave out values or return empty values.
+type InterfaceConfigInfo struct {
+	Status                string `xml:"NewStatus"`
+	ModulationType        string `xml:"NewModulationType"`
+	StandardUsed          string `xml:"NewStandardUsed"`
+	UpstreamCurrRate      string `xml:"NewUpstreamCurrRate"`
+	DownstreamCurrRate    string `xml:"NewDownstreamCurrRate"`
+	UpstreamMaxRate       string `xml:"NewUpstreamMaxRate"`
+	DownstreamMaxRate     string `xml:"NewDownstreamMaxRate"`
+	UpstreamNoiseMargin   string `xml:"NewUpstreamNoiseMargin"`
+	DownstreamNoiseMargin string `xml:"NewDownstreamNoiseMargin"`
+	UpstreamAttenuation   string `xml:"NewUpstreamAttenuation"`
+	DownstreamAttenuation string `xml:"NewDownstreamAttenuation"`
+	UpstreamPower         string `xml:"NewUpstreamPower"`
+	DownstreamPower       string `xml:"NewDownstreamPower"`
+	ATURVendor            string `xml:"NewATURVendor"`
+	ATUCVendor            string `xml:"NewATUCVendor"`
+	ShowtimeStart         string `xml:"NewShowtimeStart"`
+}
+
+// InterfaceConfigStatisticsTotal is the response of WANDSLInterfaceConfig#GetStatisticsTotal. The counters
+// without prefix are those of the ATU-R (downstream), the ones with ATUC prefix those of the ATU-C (upstream).
+type InterfaceConfigStatisticsTotal struct {
+	ShowtimeStart           string `xml:"NewShowtimeStart"`
+	ErroredSecs             string `xml:"NewErroredSecs"`
+	ATUCErroredSecs         string `xml:"NewATUCErroredSecs"`
+	SeverelyErroredSecs     string `xml:"NewSeverelyErroredSecs"`
+	ATUCSeverelyErroredSecs string `xml:"NewATUCSeverelyErroredSecs"`
+	FECErrors               string `xml:"NewFECErrors"`
+	ATUCFECErrors           string `xml:"NewATUCFECErrors"`
+	CRCErrors               string `xml:"NewCRCErrors"`
+	ATUCCRCErrors           string `xml:"NewATUCCRCErrors"`
+}
+
+func ParseInterfaceConfigInfo(data string) (*InterfaceConfigInfo, error) {
+	var envelope struct {
+		XMLName xml.Name            `xml:"Envelope"`
+		Data    InterfaceConfigInfo `xml:"Body>GetInfoResponse"`
+	}
+
+	err := xml.Unmarshal([]byte(data), &envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	return &envelope.Data, nil
+}
+
+func ParseInterfaceConfigStatisticsTotal(data string) (*InterfaceConfigStatisticsTotal, error) {
+	var envelope struct {
+		XMLName xml.Name                       `xml:"Envelope"`
+		Data    InterfaceConfigStatisticsTotal `xml:"Body>GetStatisticsTotalResponse"`
+	}
+
+	err := xml.Unmarshal([]byte(data), &envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	return &envelope.Data, nil
+}
+
+// InterpretStatistics sets the error counters of the status from the statistics.
+func InterpretStatistics(status *models.Status, statistics *InterfaceConfigStatisticsTotal) {
+	status.DownstreamFECCount = ParseIntValue(statistics.FECErrors)
+	status.UpstreamFECCount = ParseIntValue(statistics.ATUCFECErrors)
+
+	status.DownstreamCRCCount = ParseIntValue(statistics.CRCErrors)
+	status.UpstreamCRCCount = ParseIntValue(statistics.ATUCCRCErrors)
+
+	status.DownstreamESCount = ParseIntValue(statistics.ErroredSecs)
+	status.UpstreamESCount = ParseIntValue(statistics.ATUCErroredSecs)
+
+	status.DownstreamSESCount = ParseIntValue(statistics.SeverelyErroredSecs)
+	status.UpstreamSESCount = ParseIntValue(statistics.ATUCSeverelyErroredSecs)
+}
+
+func InterpretPower(data string) (out models.FloatValue) {
+	val := ParseIntValue(data)
+	if !val.Valid {
+		return
+	}
+
+	// The specification uses a unit of 0.1 dBmV, but AVM devices report the value in dBm with an
+	// offset of 500 instead.
+	if val.Int >= 400 {
+		out.Float = float64(val.Int) - 500
+	} else {
+		out.Float = float64(val.Int) * 0.1
+	}
+	out.Valid = true
+
+	return
+}
+
+func ParseIntValue(data string) (out models.IntValue) {
+	if valInt, err := strconv.ParseInt(strings.TrimSpace(data), 10, 64); err == nil {
+		out.Int = valInt
+		out.Valid = true
+	}
+	return
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at https://mozilla.org/MPL/2.0/.
 
-package sagemcom
+package tr181
 
 import (
 	"strconv"
@@ -12,7 +12,7 @@ import (
 	"3e8.eu/go/dsl/models"
 )
 
-func interpretBins(status *models.Status, data *dslObj) models.Bins {
+func interpretBins(status *models.Status, data *DSL) models.Bins {
 	var bins models.Bins
 
 	bins.Mode = status.Mode
@@ -37,7 +37,7 @@ func interpretBins(status *models.Status, data *dslObj) models.Bins {
 	return bins
 }
 
-func fixBinsData(testParams *lineTestParams) {
+func fixBinsData(testParams *LineTestParams) {
 	fixBinsDataItem(&testParams.SNRpsds, 3)
 	fixBinsDataItem(&testParams.SNRpsus, 3)
 
@@ -61,7 +61,7 @@ func fixBinsData(testParams *lineTestParams) {
 	}
 }
 
-func fixBinsDataItem(str *string, digits int) {
+func fixBinsDataItem(str *Value, digits int) {
 	// Some of the reported values contain excess data at the end. The excess data
 	// matches other values from the TestParams object, so it seems that this is
 	// because the buffers in the device software are a byte too short to actually
@@ -89,13 +89,17 @@ func fixBinsDataItem(str *string, digits int) {
 	*str = truncated
 }
 
-func interpretBinsData(out *models.BinsFloat, data string, groupSize int,
+func interpretBinsData(out *models.BinsFloat, data Value, groupSize Value,
 	defaultValue float64, processValueFunc func(val float64, scale bool) float64) {
 
-	needsScaling := !strings.ContainsRune(data, '.')
-	items := strings.Split(data, ",")
+	if data == "" {
+		return
+	}
+
+	needsScaling := !strings.ContainsRune(string(data), '.')
+	items := strings.Split(string(data), ",")
 
-	out.GroupSize = groupSize
+	out.GroupSize = int(parseIntValue(groupSize).Int)
 	out.Data = make([]float64, len(items))
 
 	for i, item := range items {
@@ -108,7 +112,7 @@ func interpretBinsData(out *models.BinsFloat, data string, groupSize int,
 	}
 }
 
-func interpretBinsDataSNR(out *models.BinsFloat, data string, groupSize int) {
+func interpretBinsDataSNR(out *models.BinsFloat, data Value, groupSize Value) {
 	processValueFunc := func(val float64, scale bool) float64 {
 		if scale {
 			return -32 + val/2
@@ -119,7 +123,7 @@ func interpretBinsDataSNR(out *models.BinsFloat, data string, groupSize int) {
 	interpretBinsData(out, data, groupSize, -32.5, processValueFunc)
 }
 
-func interpretBinsDataQLN(out *models.BinsFloat, data string, groupSize int) {
+func interpretBinsDataQLN(out *models.BinsFloat, data Value, groupSize Value) {
 	processValueFunc := func(val float64, scale bool) float64 {
 		if scale {
 			return -23 - val/2
@@ -130,7 +134,7 @@ func interpretBinsDataQLN(out *models.BinsFloat, data string, groupSize int) {
 	interpretBinsData(out, data, groupSize, 0, processValueFunc)
 }
 
-func interpretBinsDataHlog(out *models.BinsFloat, data string, groupSize int) {
+func interpretBinsDataHlog(out *models.BinsFloat, data Value, groupSize Value) {
 	processValueFunc := func(val float64, scale bool) float64 {
 		if scale {
 			return 6 - val/10
@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package tr181
+
+import (
+	"encoding/json"
+)
+
+// Value holds a single parameter value. Depending on the transport, values may be encoded as JSON
+// strings, numbers or booleans, so all of them are accepted and stored as string.
+type Value string
+
+func (v *Value) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		err := json.Unmarshal(data, &s)
+		*v = Value(s)
+		return err
+	}
+
+	if string(data) == "null" {
+		*v = ""
+	} else {
+		*v = Value(data)
+	}
+
+	return nil
+}
+
+// DSL corresponds to the Device.DSL object. Tables are represented as slices, using the naming of
+// the Sagemcom XMO API (e.g. Device.DSL.Line.{i} becomes Lines).
+type DSL struct {
+	Lines    []Line    `json:"Lines"`
+	Channels []Channel `json:"Channels"`
+}
+
+type Line struct {
+	// UID is the instance number of the table entry (named as in the XMO API)
+	UID Value `json:"uid"`
+
+	FirmwareVersion       Value          `json:"FirmwareVersion"`
+	LinkStatus            Value          `json:"LinkStatus"`
+	StandardUsed          Value          `json:"StandardUsed"`
+	UpstreamMaxBitRate    Value          `json:"UpstreamMaxBitRate"`
+	DownstreamMaxBitRate  Value          `json:"DownstreamMaxBitRate"`
+	UpstreamNoiseMargin   Value          `json:"UpstreamNoiseMargin"`
+	DownstreamNoiseMargin Value          `json:"DownstreamNoiseMargin"`
+	UpstreamAttenuation   Value          `json:"UpstreamAttenuation"`
+	DownstreamAttenuation Value          `json:"DownstreamAttenuation"`
+	UpstreamPower         Value          `json:"UpstreamPower"`
+	DownstreamPower       Value          `json:"DownstreamPower"`
+	XTURVendor            Value          `json:"XTURVendor"`
+	XTUCVendor            Value          `json:"XTUCVendor"`
+	SNRMpbds              Value          `json:"SNRMpbds"`
+	SNRMpbus              Value          `json:"SNRMpbus"`
+	Stats                 LineStats      `json:"Stats"`
+	TestParams            LineTestParams `json:"TestParams"`
+
+	// vendor extensions (Sagemcom)
+	VectoringState Value `json:"VectoringState"`
+	IDDSLAM        Value `json:"IDDSLAM"`
+	ModemChip      Value `json:"ModemChip"`
+}
+
+type LineStats struct {
+	ShowtimeStart Value             `json:"ShowtimeStart"`
+	Showtime      LineStatsCounters `json:"Showtime"`
+}
+
+type LineStatsCounters struct {
+	ErroredSecs           Value `json:"ErroredSecs"`
+	TxErroredSecs         Value `json:"TxErroredSecs"`
+	SeverelyErroredSecs   Value `json:"SeverelyErroredSecs"`
+	TxSeverelyErroredSecs Value `json:"TxSeverelyErroredSecs"`
+}
+
+type LineTestParams struct {
+	HLOGGds  Value `json:"HLOGGds"`
+	HLOGGus  Value `json:"HLOGGus"`
+	HLOGpsds Value `json:"HLOGpsds"`
+	HLOGpsus Value `json:"HLOGpsus"`
+	QLNGds   Value `json:"QLNGds"`
+	QLNGus   Value `json:"QLNGus"`
+	QLNpsds  Value `json:"QLNpsds"`
+	QLNpsus  Value `json:"QLNpsus"`
+	SNRGds   Value `json:"SNRGds"`
+	SNRGus   Value `json:"SNRGus"`
+	SNRpsds  Value `json:"SNRpsds"`
+	SNRpsus  Value `json:"SNRpsus"`
+	LATNds   Value `json:"LATNds"`
+	LATNus   Value `json:"LATNus"`
+	SATNds   Value `json:"SATNds"`
+	SATNus   Value `json:"SATNus"`
+}
+
+type Channel struct {
+	Status      Value `json:"Status"`
+	LowerLayers Value `json:"LowerLayers"`
+
+	ActualInterleavingDelay Value        `json:"ActualInterleavingDelay"`
+	ACTINP                  Value        `json:"ACTINP"`
+	ACTNDRds                Value        `json:"ACTNDRds"`
+	ACTNDRus                Value        `json:"ACTNDRus"`
+	ACTINPREINds            Value        `json:"ACTINPREINds"`
+	ACTINPREINus            Value        `json:"ACTINPREINus"`
+	UpstreamCurrRate        Value        `json:"UpstreamCurrRate"`
+	DownstreamCurrRate      Value        `json:"DownstreamCurrRate"`
+	Stats                   ChannelStats `json:"Stats"`
+
+	// vendor extensions (Sagemcom)
+	ActualInterleavingDelayus Value `json:"ActualInterleavingDelayus"`
+	ACTINPus                  Value `json:"ACTINPus"`
+}
+
+type ChannelStats struct {
+	Showtime ChannelStatsCounters `json:"Showtime"`
+}
+
+type ChannelStatsCounters struct {
+	XTURFECErrors Value `json:"XTURFECErrors"`
+	XTUCFECErrors Value `json:"XTUCFECErrors"`
+	XTURCRCErrors Value `json:"XTURCRCErrors"`
+	XTUCCRCErrors Value `json:"XTUCCRCErrors"`
+}
@@ -0,0 +1,170 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package tr181
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const prefixDSL = "Device.DSL."
+
+type table map[int]map[string]interface{}
+
+// ParseParameters converts a list of parameters with their full path (e.g. Device.DSL.Line.1.LinkStatus)
+// into a DSL object. Parameters outside of Device.DSL are ignored.
+func ParseParameters(params map[string]string) (*DSL, error) {
+	root := make(map[string]interface{})
+
+	for path, value := range params {
+		if !strings.HasPrefix(path, prefixDSL) {
+			continue
+		}
+
+		parts := strings.Split(path[len(prefixDSL):], ".")
+		insertParameter(root, parts, value)
+	}
+
+	if len(root) == 0 {
+		return nil, errors.New("no parameters of Device.DSL found")
+	}
+
+	data, err := json.Marshal(convertTables(root))
+	if err != nil {
+		return nil, err
+	}
+
+	var dsl DSL
+	err = json.Unmarshal(data, &dsl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dsl, nil
+}
+
+func insertParameter(obj map[string]interface{}, parts []string, value string) {
+	// paths of objects or table entries (e.g. Device.DSL.Line.1) have no value to insert
+	if len(parts) == 0 {
+		return
+	}
+
+	if len(parts) == 1 {
+		if parts[0] != "" {
+			obj[parts[0]] = value
+		}
+		return
+	}
+
+	// tables use the plural form of the name, as in the XMO API
+	if index, err := strconv.Atoi(parts[1]); err == nil {
+		t, ok := obj[parts[0]+"s"].(table)
+		if !ok {
+			t = make(table)
+			obj[parts[0]+"s"] = t
+		}
+
+		entry, ok := t[index]
+		if !ok {
+			entry = make(map[string]interface{})
+			t[index] = entry
+		}
+
+		insertParameter(entry, parts[2:], value)
+		return
+	}
+
+	child, ok := obj[parts[0]].(map[string]interface{})
+	if !ok {
+		child = make(map[string]interface{})
+		obj[parts[0]] = child
+	}
+
+	insertParameter(child, parts[1:], value)
+}
+
+func convertTables(obj map[string]interface{}) map[string]interface{} {
+	for key, val := range obj {
+		switch val := val.(type) {
+
+		case map[string]interface{}:
+			obj[key] = convertTables(val)
+
+		case table:
+			indexes := make([]int, 0, len(val))
+			for index := range val {
+				indexes = append(indexes, index)
+			}
+			sort.Ints(indexes)
+
+			entries := make([]interface{}, len(indexes))
+			for i, index := range indexes {
+				entry := convertTables(val[index])
+				if _, ok := entry["uid"]; !ok {
+					entry["uid"] = strconv.Itoa(index)
+				}
+				entries[i] = entry
+			}
+			obj[key] = entries
+
+		}
+	}
+
+	return obj
+}
+
+// ParseJSON parses JSON data containing a DSL object. Both the nested format of the XMO API (with
+// the DSL object at the top level) and flat parameter lists are supported. In the latter case, keys
+// may either be full parameter paths, or object paths with the parameters as nested object (as used
+// for USP Get responses).
+func ParseJSON(data []byte) (*DSL, error) {
+	var items map[string]json.RawMessage
+	err := json.Unmarshal(data, &items)
+	if err != nil {
+		return nil, err
+	}
+
+	if dslData, ok := items["DSL"]; ok {
+		var dsl DSL
+		err = json.Unmarshal(dslData, &dsl)
+		if err != nil {
+			return nil, err
+		}
+		return &dsl, nil
+	}
+
+	params := make(map[string]string)
+
+	for path, val := range items {
+		if len(val) > 0 && val[0] == '{' {
+			var objectParams map[string]Value
+			err = json.Unmarshal(val, &objectParams)
+			if err != nil {
+				return nil, err
+			}
+
+			if !strings.HasSuffix(path, ".") {
+				path += "."
+			}
+			for name, value := range objectParams {
+				params[path+name] = string(value)
+			}
+
+			continue
+		}
+
+		var value Value
+		err = json.Unmarshal(val, &value)
+		if err != nil {
+			return nil, err
+		}
+		params[path] = string(value)
+	}
+
+	return ParseParameters(params)
+}
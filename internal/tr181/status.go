@@ -0,0 +1,321 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package tr181
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"3e8.eu/go/dsl/internal/helpers"
+	"3e8.eu/go/dsl/models"
+)
+
+var regexpMode = regexp.MustCompile(`(?i)^G[_\.]([0-9]{3})[_\.]([0-9])_annex_(b)$`)
+
+const regexpModeReplacement = `G.$1.$2 Annex $3`
+
+var regexpLineReference = regexp.MustCompile(`Line\D*([0-9]+)`)
+
+// Interpret maps the data of a Device.DSL object to status and bins. If there are multiple lines or
+// channels, the line which is up and the channel on top of it are used.
+func Interpret(data *DSL) (status models.Status, bins models.Bins, err error) {
+	if len(data.Lines) == 0 || len(data.Channels) == 0 {
+		err = fmt.Errorf("unexpected number of lines (%d) or channels (%d)",
+			len(data.Lines), len(data.Channels))
+		return
+	}
+
+	line := selectLine(data.Lines)
+	selected := &DSL{
+		Lines:    []Line{line},
+		Channels: []Channel{selectChannel(data.Channels, &line)},
+	}
+
+	status = interpretStatus(selected)
+	bins = interpretBins(&status, selected)
+	interpretStatusBands(&status, &bins, selected)
+
+	return
+}
+
+func selectLine(lines []Line) Line {
+	for _, line := range lines {
+		if strings.EqualFold(string(line.LinkStatus), "Up") {
+			return line
+		}
+	}
+	return lines[0]
+}
+
+// selectChannel returns the channel referencing the line in LowerLayers (preferring one which is up),
+// or otherwise the first channel which is up
+func selectChannel(channels []Channel, line *Line) Channel {
+	var referencing []Channel
+	for _, channel := range channels {
+		if channelReferencesLine(&channel, line) {
+			referencing = append(referencing, channel)
+		}
+	}
+	if len(referencing) != 0 {
+		channels = referencing
+	}
+
+	for _, channel := range channels {
+		if strings.EqualFold(string(channel.Status), "Up") {
+			return channel
+		}
+	}
+	return channels[0]
+}
+
+func channelReferencesLine(channel *Channel, line *Line) bool {
+	uid := strings.TrimSpace(string(line.UID))
+	if uid == "" {
+		return false
+	}
+
+	// references are full object paths, e.g. Device.DSL.Line.1 or Device/DSL/Lines/Line[@uid='1'] for XMO
+	for _, layer := range strings.Split(string(channel.LowerLayers), ",") {
+		if match := regexpLineReference.FindStringSubmatch(layer); match != nil && match[1] == uid {
+			return true
+		}
+	}
+
+	return false
+}
+
+func interpretStatus(data *DSL) models.Status {
+	var status models.Status
+
+	interpretStatusState(&status, data)
+	interpretStatusMode(&status, data)
+	interpretStatusUptime(&status, data)
+	interpretStatusInventory(&status, data)
+	interpretStatusRates(&status, data)
+	interpretStatusImpulseNoise(&status, data)
+	interpretStatusVectoring(&status, data)
+	interpretStatusSignal(&status, data)
+	interpretStatusCounters(&status, data)
+
+	helpers.CompleteRetransmissionStatus(&status)
+
+	return status
+}
+
+func interpretStatusBands(status *models.Status, bins *models.Bins, data *DSL) {
+	if status.Mode.Type != models.ModeTypeVDSL2 {
+		return
+	}
+
+	line := &data.Lines[0]
+
+	status.DownstreamBands = interpretStatusBandsList(bins.Bands, false,
+		line.TestParams.LATNds, line.TestParams.SATNds, line.SNRMpbds)
+	status.UpstreamBands = interpretStatusBandsList(bins.Bands, true,
+		line.TestParams.LATNus, line.TestParams.SATNus, line.SNRMpbus)
+}
+
+func interpretStatusBandsList(bands models.BandsDownUp, upstream bool, latn, satn, snrm Value) (out []models.BandStatus) {
+	latnValues := parseFloatValueList(latn)
+	satnValues := parseFloatValueList(satn)
+	snrmValues := parseFloatValueList(snrm)
+
+	// some devices only report a single total value instead of the per-band values
+	if len(latnValues) <= 1 && len(satnValues) <= 1 && len(snrmValues) <= 1 {
+		return
+	}
+
+	count := len(latnValues)
+	if len(satnValues) > count {
+		count = len(satnValues)
+	}
+	if len(snrmValues) > count {
+		count = len(snrmValues)
+	}
+
+	for i := 0; i < count; i++ {
+		band := models.BandStatus{Name: helpers.BandName(bands, upstream, i)}
+		if i < len(latnValues) {
+			band.Attenuation.FloatValue = latnValues[i]
+		}
+		if i < len(satnValues) {
+			band.SignalAttenuation.FloatValue = satnValues[i]
+		}
+		if i < len(snrmValues) {
+			band.SNRMargin.FloatValue = snrmValues[i]
+		}
+
+		if band.IsValid() {
+			out = append(out, band)
+		}
+	}
+
+	return
+}
+
+// parseFloatValueList parses a comma-separated list of values in 0.1 dB, ignoring the special values
+// which indicate that no data is available for a band.
+func parseFloatValueList(data Value) []models.FloatValue {
+	if strings.TrimSpace(string(data)) == "" {
+		return nil
+	}
+
+	items := strings.Split(string(data), ",")
+	out := make([]models.FloatValue, len(items))
+
+	for i, item := range items {
+		val := parseIntValue(Value(item))
+		if val.Valid && val.Int > -512 && val.Int < 1271 {
+			out[i] = convertFloatValue(val, 0.1)
+		}
+	}
+
+	return out
+}
+
+func interpretStatusState(status *models.Status, data *DSL) {
+	linkStatus := string(data.Lines[0].LinkStatus)
+	status.State = helpers.ParseStateTR06X(linkStatus)
+}
+
+func interpretStatusMode(status *models.Status, data *DSL) {
+	mode := string(data.Lines[0].StandardUsed)
+	mode = regexpMode.ReplaceAllString(mode, regexpModeReplacement)
+	status.Mode = helpers.ParseMode(mode)
+}
+
+func interpretStatusUptime(status *models.Status, data *DSL) {
+	if status.State != models.StateShowtime {
+		return
+	}
+
+	showtimeStart := parseIntValue(data.Lines[0].Stats.ShowtimeStart)
+	if showtimeStart.Valid {
+		status.Uptime.Valid = true
+		status.Uptime.Duration = time.Duration(showtimeStart.Int) * time.Second
+	}
+}
+
+func interpretStatusInventory(status *models.Status, data *DSL) {
+	line := &data.Lines[0]
+
+	if len(line.IDDSLAM) == 11 {
+		status.FarEndInventory.Vendor = helpers.FormatVendor(string(line.IDDSLAM[0:4]))
+		version := helpers.ParseHexadecimal(string(line.IDDSLAM[7:11]))
+		status.FarEndInventory.Version = helpers.FormatVersion(status.FarEndInventory.Vendor, version)
+	} else if len(line.XTUCVendor) == 8 {
+		vendor := helpers.ParseHexadecimal(string(line.XTUCVendor))
+		status.FarEndInventory.Vendor = helpers.FormatVendor(string(vendor))
+	}
+
+	if len(line.XTURVendor) == 8 {
+		vendor := helpers.ParseHexadecimal(string(line.XTURVendor))
+		status.NearEndInventory.Vendor = helpers.FormatVendor(string(vendor))
+	} else if line.ModemChip != "" {
+		status.NearEndInventory.Vendor = string(line.ModemChip)
+	} else if strings.HasPrefix(string(line.FirmwareVersion), "A2pv") ||
+		strings.HasPrefix(string(line.FirmwareVersion), "B2pv") {
+		status.NearEndInventory.Vendor = "Broadcom"
+	}
+
+	status.NearEndInventory.Version = string(line.FirmwareVersion)
+}
+
+func interpretStatusRates(status *models.Status, data *DSL) {
+	// this is not correct when G.INP is enabled, as these values report the "Actual Data Rate" instead of the
+	// "Actual Net Data Rate", but the latter doesn't seem to be exposed at all, so this is the best we can have
+	status.UpstreamActualRate.IntValue = parseIntValue(data.Channels[0].UpstreamCurrRate)
+	status.DownstreamActualRate.IntValue = parseIntValue(data.Channels[0].DownstreamCurrRate)
+
+	status.UpstreamAttainableRate.IntValue = parseIntValue(data.Lines[0].UpstreamMaxBitRate)
+	status.DownstreamAttainableRate.IntValue = parseIntValue(data.Lines[0].DownstreamMaxBitRate)
+}
+
+func interpretStatusImpulseNoise(status *models.Status, data *DSL) {
+	status.UpstreamInterleavingDelay.FloatValue = parseFloatValue(data.Channels[0].ActualInterleavingDelayus, 0.01)
+	status.DownstreamInterleavingDelay.FloatValue = parseFloatValue(data.Channels[0].ActualInterleavingDelay, 0.01)
+
+	status.UpstreamImpulseNoiseProtection.FloatValue = parseFloatValue(data.Channels[0].ACTINPus, 0.1)
+	status.DownstreamImpulseNoiseProtection.FloatValue = parseFloatValue(data.Channels[0].ACTINP, 0.1)
+
+	status.UpstreamRetransmission.NetDataRate.IntValue = parseIntValue(data.Channels[0].ACTNDRus)
+	status.DownstreamRetransmission.NetDataRate.IntValue = parseIntValue(data.Channels[0].ACTNDRds)
+
+	status.UpstreamRetransmission.ImpulseNoiseProtectionREIN.FloatValue = parseFloatValue(data.Channels[0].ACTINPREINus, 0.1)
+	status.DownstreamRetransmission.ImpulseNoiseProtectionREIN.FloatValue = parseFloatValue(data.Channels[0].ACTINPREINds, 0.1)
+}
+
+func interpretStatusVectoring(status *models.Status, data *DSL) {
+	vectoring := strings.ToLower(string(data.Lines[0].VectoringState))
+	if vectoring == "running" {
+		status.DownstreamVectoringState.State = models.VectoringStateFull
+		status.DownstreamVectoringState.Valid = true
+	} else if vectoring == "disabled" {
+		status.DownstreamVectoringState.State = models.VectoringStateOff
+		status.DownstreamVectoringState.Valid = true
+	}
+}
+
+func interpretStatusSignal(status *models.Status, data *DSL) {
+	status.UpstreamAttenuation.FloatValue = parseFloatValue(data.Lines[0].UpstreamAttenuation, 0.1)
+	status.DownstreamAttenuation.FloatValue = parseFloatValue(data.Lines[0].DownstreamAttenuation, 0.1)
+
+	if data.Lines[0].ModemChip == "Lantiq" {
+		// At least on Speedport Pro with firmware 4.5, the downstream and upstream
+		// attenuation values are swapped. In addition, it seems that the Attenuation
+		// and SignalAttenuation values are also swapped. However, the LATN values in
+		// the TestParams object seem to be correct (those should actually report the
+		// per-band attenuation, but the Lantiq FAPI reports total values instead).
+
+		upstreamLATN := parseIntValue(data.Lines[0].TestParams.LATNus)
+		downstreamLATN := parseIntValue(data.Lines[0].TestParams.LATNds)
+
+		if upstreamLATN.Valid && downstreamLATN.Valid {
+			status.UpstreamAttenuation.FloatValue = convertFloatValue(upstreamLATN, 0.1)
+			status.DownstreamAttenuation.FloatValue = convertFloatValue(downstreamLATN, 0.1)
+		}
+	}
+
+	status.UpstreamSNRMargin.FloatValue = parseFloatValue(data.Lines[0].UpstreamNoiseMargin, 0.1)
+	status.DownstreamSNRMargin.FloatValue = parseFloatValue(data.Lines[0].DownstreamNoiseMargin, 0.1)
+
+	status.UpstreamPower.FloatValue = parseFloatValue(data.Lines[0].UpstreamPower, 0.1)
+	status.DownstreamPower.FloatValue = parseFloatValue(data.Lines[0].DownstreamPower, 0.1)
+}
+
+func interpretStatusCounters(status *models.Status, data *DSL) {
+	status.UpstreamFECCount = parseIntValue(data.Channels[0].Stats.Showtime.XTUCFECErrors)
+	status.DownstreamFECCount = parseIntValue(data.Channels[0].Stats.Showtime.XTURFECErrors)
+
+	status.UpstreamCRCCount = parseIntValue(data.Channels[0].Stats.Showtime.XTUCCRCErrors)
+	status.DownstreamCRCCount = parseIntValue(data.Channels[0].Stats.Showtime.XTURCRCErrors)
+
+	status.UpstreamESCount = parseIntValue(data.Lines[0].Stats.Showtime.TxErroredSecs)
+	status.DownstreamESCount = parseIntValue(data.Lines[0].Stats.Showtime.ErroredSecs)
+
+	status.UpstreamSESCount = parseIntValue(data.Lines[0].Stats.Showtime.TxSeverelyErroredSecs)
+	status.DownstreamSESCount = parseIntValue(data.Lines[0].Stats.Showtime.SeverelyErroredSecs)
+}
+
+func parseIntValue(data Value) (out models.IntValue) {
+	if valInt, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64); err == nil {
+		out.Int = valInt
+		out.Valid = true
+	}
+	return
+}
+
+func parseFloatValue(data Value, factor float64) models.FloatValue {
+	return convertFloatValue(parseIntValue(data), factor)
+}
+
+func convertFloatValue(val models.IntValue, factor float64) (out models.FloatValue) {
+	out.Float = float64(val.Int) * factor
+	out.Valid = val.Valid
+	return
+}
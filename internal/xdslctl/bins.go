@@ -2,10 +2,11 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at https://mozilla.org/MPL/2.0/.
 
-package broadcom
+package xdslctl
 
 import (
 	"bufio"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
@@ -16,7 +17,7 @@ import (
 
 var regexpPbParams = regexp.MustCompile(`\((\d+),(\d+)\)`)
 
-func parseBins(status models.Status, pbParams, bits, snr, qln, hlog string) models.Bins {
+func ParseBins(status models.Status, pbParams, bits, snr, qln, hlog string) models.Bins {
 	var bins models.Bins
 
 	bins.Mode = status.Mode
@@ -205,7 +206,171 @@ func parseHlog(bins *models.Bins, hlog string) {
 	adjustGroupSize(&bins.Hlog.Upstream, isValid)
 }
 
+// ParseFEXTCancellation parses the per-tone FEXT cancellation gain, which is part of the output of
+// "xdslctl info --vectoring" on some firmware versions. This requires the band plan to be already set.
+func ParseFEXTCancellation(bins *models.Bins, vectoring string) {
+	binCount := bins.Mode.BinCount()
+
+	downstream := make([]float64, binCount)
+	upstream := make([]float64, binCount)
+	var found bool
+
+	var val float64
+	var err error
+	parseBinList(vectoring, bins.Bands, func(num int, str string, isDownstream bool) {
+		if num >= binCount {
+			return
+		}
+
+		val, err = strconv.ParseFloat(str, 64)
+
+		if err == nil && val > 0 && val <= 100 {
+			if isDownstream {
+				downstream[num] = val
+			} else {
+				upstream[num] = val
+			}
+			found = true
+		}
+	})
+
+	if !found {
+		return
+	}
+
+	bins.FEXTCancellation.Downstream.GroupSize = 1
+	bins.FEXTCancellation.Downstream.Data = downstream
+
+	bins.FEXTCancellation.Upstream.GroupSize = 1
+	bins.FEXTCancellation.Upstream.Data = upstream
+
+	isValid := func(val float64) bool {
+		return val > 0
+	}
+	adjustGroupSize(&bins.FEXTCancellation.Downstream, isValid)
+	adjustGroupSize(&bins.FEXTCancellation.Upstream, isValid)
+}
+
+// ParseGains parses the fine gains from the output of "xdslctl info --gains". This requires the band plan to be
+// already set.
+func ParseGains(bins *models.Bins, gains string) {
+	binCount := bins.Mode.BinCount()
+
+	downstream := make([]float64, binCount)
+	upstream := make([]float64, binCount)
+	for num := 0; num < binCount; num++ {
+		downstream[num] = -14.6
+		upstream[num] = -14.6
+	}
+	var found bool
+
+	var val float64
+	var err error
+	parseBinList(gains, bins.Bands, func(num int, str string, isDownstream bool) {
+		if num >= binCount {
+			return
+		}
+
+		val, err = strconv.ParseFloat(str, 64)
+
+		if err == nil && val > 0 {
+			if isDownstream {
+				downstream[num] = helpers.ConvertGain(int64(math.Round(val)))
+			} else {
+				upstream[num] = helpers.ConvertGain(int64(math.Round(val)))
+			}
+			found = true
+		}
+	})
+
+	if !found {
+		return
+	}
+
+	bins.Gains.Downstream.GroupSize = 1
+	bins.Gains.Downstream.Data = downstream
+
+	bins.Gains.Upstream.GroupSize = 1
+	bins.Gains.Upstream.Data = upstream
+
+	isValid := func(val float64) bool {
+		return val >= -14.5
+	}
+	adjustGroupSize(&bins.Gains.Downstream, isValid)
+	adjustGroupSize(&bins.Gains.Upstream, isValid)
+}
+
+// ParseHlin parses the complex channel characteristic from the output of "xdslctl info --Hlin". This requires
+// the band plan to be already set.
+func ParseHlin(bins *models.Bins, hlin string) {
+	binCount := bins.Mode.BinCount()
+
+	// according to G.997.1, the values need to be multiplied by the scale and divided by 2^30
+	factor := 1.0
+	scanner := bufio.NewScanner(strings.NewReader(hlin))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.Contains(strings.ToLower(line), "scale") {
+			fields := strings.FieldsFunc(line, func(r rune) bool {
+				return r == ' ' || r == '\t' || r == ':' || r == '='
+			})
+			if len(fields) != 0 {
+				if scale, err := strconv.ParseFloat(fields[len(fields)-1], 64); err == nil && scale > 0 {
+					factor = scale / (1 << 30)
+				}
+			}
+			break
+		}
+	}
+
+	var down, up models.BinsComplex
+	down.Real = make([]float64, binCount)
+	down.Imag = make([]float64, binCount)
+	up.Real = make([]float64, binCount)
+	up.Imag = make([]float64, binCount)
+	var found bool
+
+	parseBinListFields(hlin, bins.Bands, func(num int, values []string, isDownstream bool) {
+		if num >= binCount || len(values) != 2 {
+			return
+		}
+
+		valReal, errReal := strconv.ParseFloat(values[0], 64)
+		valImag, errImag := strconv.ParseFloat(values[1], 64)
+		if errReal != nil || errImag != nil || (valReal == 0 && valImag == 0) {
+			return
+		}
+
+		out := &up
+		if isDownstream {
+			out = &down
+		}
+		out.Real[num] = valReal * factor
+		out.Imag[num] = valImag * factor
+		found = true
+	})
+
+	if !found {
+		return
+	}
+
+	down.GroupSize = 1
+	up.GroupSize = 1
+
+	bins.Hlin.Downstream = down
+	bins.Hlin.Upstream = up
+}
+
 func parseBinList(text string, bands models.BandsDownUp, handler func(int, string, bool)) {
+	parseBinListFields(text, bands, func(num int, values []string, isDownstream bool) {
+		if len(values) == 1 {
+			handler(num, values[0], isDownstream)
+		}
+	})
+}
+
+func parseBinListFields(text string, bands models.BandsDownUp, handler func(int, []string, bool)) {
 	scanner := bufio.NewScanner(strings.NewReader(text))
 
 	for scanner.Scan() {
@@ -226,11 +391,14 @@ func parseBinList(text string, bands models.BandsDownUp, handler func(int, strin
 		line := scanner.Text()
 
 		data := strings.Fields(line)
-		if len(data) == 2 {
-			num, _ := strconv.Atoi(data[0])
-			str := data[1]
+		if len(data) >= 2 {
+			// skip captions and separators, which would otherwise overwrite the data of tone 0
+			num, err := strconv.Atoi(data[0])
+			if err != nil || num < 0 {
+				continue
+			}
 
-			handler(num, str, bandDecider.IsDownstream(num))
+			handler(num, data[1:], bandDecider.IsDownstream(num))
 		}
 	}
 }
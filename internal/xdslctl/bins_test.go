@@ -0,0 +1,81 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package xdslctl
+
+import (
+	"os"
+	"testing"
+
+	"3e8.eu/go/dsl/models"
+)
+
+func TestParseFEXTCancellation(t *testing.T) {
+	vectoring, err := os.ReadFile("testdata/vectoring_fext.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bins := models.Bins{
+		Mode: models.Mode{Type: models.ModeTypeVDSL2, Subtype: models.ModeSubtypeProfile17a},
+		Bands: models.BandsDownUp{
+			Downstream: []models.Band{{Start: 33, End: 859}},
+			Upstream:   []models.Band{{Start: 870, End: 1205}},
+		},
+	}
+
+	ParseFEXTCancellation(&bins, string(vectoring))
+
+	downstream := bins.FEXTCancellation.Downstream
+	upstream := bins.FEXTCancellation.Upstream
+
+	if downstream.GroupSize != 1 || upstream.GroupSize != 1 {
+		t.Fatalf("unexpected group sizes: %d, %d", downstream.GroupSize, upstream.GroupSize)
+	}
+
+	tests := []struct {
+		name     string
+		data     []float64
+		tone     int
+		expected float64
+	}{
+		// the total in the last row must not be stored as tone 0
+		{"downstream tone 0", downstream.Data, 0, 0},
+		{"downstream tone 33", downstream.Data, 33, 10},
+		{"downstream tone 39", downstream.Data, 39, 13},
+		{"upstream tone 0", upstream.Data, 0, 0},
+		{"upstream tone 870", upstream.Data, 870, 5},
+		{"upstream tone 872", upstream.Data, 872, 6},
+	}
+
+	for _, test := range tests {
+		if test.tone >= len(test.data) {
+			t.Errorf("%s: missing data", test.name)
+			continue
+		}
+		if test.data[test.tone] != test.expected {
+			t.Errorf("%s: got %.2f, expected %.2f", test.name, test.data[test.tone], test.expected)
+		}
+	}
+}
+
+func TestParseVectoring(t *testing.T) {
+	vectoring, err := os.ReadFile("testdata/vectoring_fext.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var status models.Status
+	parseVectoring(&status, string(vectoring))
+
+	if status.Vectoring.MachineState != "3" {
+		t.Errorf("unexpected vectoring state: %q", status.Vectoring.MachineState)
+	}
+	if !status.Vectoring.ErrorSampleCount.Valid || status.Vectoring.ErrorSampleCount.Int != 193522 {
+		t.Errorf("unexpected error sample count: %v", status.Vectoring.ErrorSampleCount)
+	}
+	if !status.Vectoring.DiscardedErrorSampleCount.Valid || status.Vectoring.DiscardedErrorSampleCount.Int != 0 {
+		t.Errorf("unexpected discarded error sample count: %v", status.Vectoring.DiscardedErrorSampleCount)
+	}
+}
@@ -0,0 +1,142 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package xdslctl
+
+import (
+	"bufio"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"3e8.eu/go/dsl/internal/helpers"
+	"3e8.eu/go/dsl/models"
+)
+
+var regexpUPBOBandName = regexp.MustCompile(`^US?([0-9])$`)
+var regexpPSDBreakpoint = regexp.MustCompile(`\((\d+),\s*(-?\d+(?:\.\d+)?)\)`)
+var regexpPSDDirection = regexp.MustCompile(`(?i)\b(us|upstream|ds|downstream)\b`)
+
+// ParsePowerBackOff parses the UPBO and DPBO parameters from the output of "xdslctl info --UPBO".
+func ParsePowerBackOff(status *models.Status, upbo string) {
+	var bands []*models.UPBOBand
+
+	scanner := bufio.NewScanner(strings.NewReader(upbo))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		split := strings.SplitN(line, ":", 2)
+		if len(split) != 2 {
+			continue
+		}
+
+		key := strings.ToLower(regexpFilterCharacters.ReplaceAllString(split[0], ""))
+		valSplit := strings.Fields(split[1])
+
+		if key == "upbo" {
+			bands = nil
+			for _, field := range valSplit {
+				if match := regexpUPBOBandName.FindStringSubmatch(field); match != nil {
+					bands = append(bands, &models.UPBOBand{Name: "U" + match[1]})
+				}
+			}
+			continue
+		}
+
+		switch key {
+
+		case "a", "b":
+			for i, val := range valSplit {
+				if i >= len(bands) {
+					break
+				}
+
+				valFloat, err := strconv.ParseFloat(val, 64)
+				if err != nil {
+					continue
+				}
+
+				if key == "a" {
+					bands[i].A.Float = valFloat
+					bands[i].A.Valid = true
+				} else {
+					bands[i].B.Float = valFloat
+					bands[i].B.Valid = true
+				}
+			}
+
+		case "kl0", "upbokl", "upbokl0":
+			interpretPowerBackOffValue(&status.PowerBackOff.UPBOElectricalLength.FloatValue, valSplit)
+
+		case "esel", "dpboesel":
+			interpretPowerBackOffValue(&status.PowerBackOff.DPBOElectricalLength.FloatValue, valSplit)
+
+		case "mus", "dpbomus":
+			interpretPowerBackOffValue(&status.PowerBackOff.DPBOMinimumUsableSignal.FloatValue, valSplit)
+
+		}
+	}
+
+	for _, band := range bands {
+		// bands with both parameters set to zero are unused
+		if band.IsValid() && (band.A.Float != 0 || band.B.Float != 0) {
+			status.PowerBackOff.UPBOBands = append(status.PowerBackOff.UPBOBands, *band)
+		}
+	}
+}
+
+func interpretPowerBackOffValue(out *models.FloatValue, valSplit []string) {
+	if len(valSplit) == 0 {
+		return
+	}
+
+	if valFloat, err := strconv.ParseFloat(valSplit[0], 64); err == nil {
+		out.Float = valFloat
+		out.Valid = true
+	}
+}
+
+// ParsePSD parses the breakpoints of the transmit PSD masks from the output of "xdslctl info --linediag". This
+// requires the band plan to be already set.
+func ParsePSD(bins *models.Bins, linediag string) {
+	var breakpointsDown, breakpointsUp []helpers.PSDBreakpoint
+	var current *[]helpers.PSDBreakpoint
+
+	scanner := bufio.NewScanner(strings.NewReader(linediag))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		lineLower := strings.ToLower(line)
+
+		if strings.Contains(lineLower, "psd") {
+			current = nil
+			if match := regexpPSDDirection.FindStringSubmatch(lineLower); match != nil {
+				if match[1] == "us" || match[1] == "upstream" {
+					current = &breakpointsUp
+				} else {
+					current = &breakpointsDown
+				}
+			}
+		} else if strings.TrimSpace(line) == "" {
+			current = nil
+		}
+
+		if current == nil {
+			continue
+		}
+
+		for _, match := range regexpPSDBreakpoint.FindAllStringSubmatch(line, -1) {
+			bin, _ := strconv.Atoi(match[1])
+			psd, _ := strconv.ParseFloat(match[2], 64)
+
+			*current = append(*current, helpers.PSDBreakpoint{Bin: bin, PSD: psd})
+		}
+	}
+
+	binCount := bins.Mode.BinCount()
+
+	helpers.GeneratePSDData(&bins.PSD.Downstream, binCount, bins.Bands.Downstream, breakpointsDown)
+	helpers.GeneratePSDData(&bins.PSD.Upstream, binCount, bins.Bands.Upstream, breakpointsUp)
+}
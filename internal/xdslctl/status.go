@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at https://mozilla.org/MPL/2.0/.
 
-package broadcom
+package xdslctl
 
 import (
 	"bufio"
@@ -16,8 +16,10 @@ import (
 )
 
 var regexpFilterCharacters = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+var regexpBandName = regexp.MustCompile(`^[UD][0-9]$`)
+var regexpRetrainCounter = regexp.MustCompile(`(?m)^(Retr|FailedRetr):\s*([0-9]+)\s*$`)
 
-func parseStatus(stats, vectoring, vendor, version string) models.Status {
+func ParseStatus(stats, vectoring, vendor, version string) models.Status {
 	var status models.Status
 
 	parseStats(&status, stats)
@@ -35,6 +37,28 @@ func parseStats(status *models.Status, stats string) {
 	extendedStats, linkTime := parseExtendedStats(stats)
 	interpretExtendedStats(status, extendedStats)
 	interpretLinkTime(status, linkTime)
+
+	parseRetrainCounters(status, stats)
+
+	helpers.CompleteRetransmissionStatus(status)
+}
+
+// parseRetrainCounters parses the retrain counters, which are only listed once with a single value
+// (as part of the total counters)
+func parseRetrainCounters(status *models.Status, stats string) {
+	for _, match := range regexpRetrainCounter.FindAllStringSubmatch(stats, -1) {
+		val, err := strconv.ParseInt(match[2], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		switch match[1] {
+		case "Retr":
+			status.RetrainCount = models.IntValue{Int: val, Valid: true}
+		case "FailedRetr":
+			status.FailedRetrainCount = models.IntValue{Int: val, Valid: true}
+		}
+	}
 }
 
 func parseBasicStats(stats string) map[string]string {
@@ -115,6 +139,43 @@ func interpretBasicStats(status *models.Status, values map[string]string) {
 
 	status.DownstreamAttainableRate.IntValue, status.UpstreamAttainableRate.IntValue = interpretBasicStatsRate(values, "max")
 	status.DownstreamActualRate.IntValue, status.UpstreamActualRate.IntValue = interpretBasicStatsRate(values, "bearer")
+
+	tpstc := strings.ToUpper(interpretBasicStatsString(values, "tpstc"))
+	if strings.HasPrefix(tpstc, "PTM") {
+		status.Configuration.TransportMode = models.TransportModePTM
+	} else if strings.HasPrefix(tpstc, "ATM") {
+		status.Configuration.TransportMode = models.TransportModeATM
+	}
+
+	status.Configuration.DownstreamTrellis, status.Configuration.UpstreamTrellis = interpretBasicStatsTrellis(values, "trellis")
+}
+
+// interpretBasicStatsTrellis parses values such as "U:ON /D:OFF"
+func interpretBasicStatsTrellis(values map[string]string, key string) (downstream, upstream models.BoolValue) {
+	if val, ok := values[key]; ok {
+		for _, item := range strings.Split(val, "/") {
+			split := strings.SplitN(strings.TrimSpace(item), ":", 2)
+			if len(split) != 2 {
+				continue
+			}
+
+			var out models.BoolValue
+			switch strings.ToUpper(strings.TrimSpace(split[1])) {
+			case "ON":
+				out = models.BoolValue{Bool: true, Valid: true}
+			case "OFF":
+				out = models.BoolValue{Bool: false, Valid: true}
+			}
+
+			switch strings.ToUpper(split[0]) {
+			case "U":
+				upstream = out
+			case "D":
+				downstream = out
+			}
+		}
+	}
+	return
 }
 
 func interpretBasicStatsString(values map[string]string, key string) string {
@@ -210,8 +271,16 @@ func interpretExtendedStats(status *models.Status, values map[string][2]string)
 	status.DownstreamInterleavingDelay.FloatValue, status.UpstreamInterleavingDelay.FloatValue = interpretExtendedStatsFloatValue(values, "delay")
 	status.DownstreamImpulseNoiseProtection.FloatValue, status.UpstreamImpulseNoiseProtection.FloatValue =
 		interpretExtendedStatsFloatValue(values, "inp")
+	status.DownstreamRetransmission.ImpulseNoiseProtectionREIN.FloatValue, status.UpstreamRetransmission.ImpulseNoiseProtectionREIN.FloatValue =
+		interpretExtendedStatsFloatValue(values, "inprein")
 	status.DownstreamRetransmissionEnabled, status.UpstreamRetransmissionEnabled = interpretExtendedStatsBoolValueNonZero(values, "q")
 
+	// NDR and ETR are only listed by some firmware versions
+	status.DownstreamRetransmission.NetDataRate.IntValue, status.UpstreamRetransmission.NetDataRate.IntValue =
+		interpretExtendedStatsIntValue(values, "ndr")
+	status.DownstreamRetransmission.ExpectedThroughput.IntValue, status.UpstreamRetransmission.ExpectedThroughput.IntValue =
+		interpretExtendedStatsIntValue(values, "etr")
+
 	status.DownstreamAttenuation.FloatValue, status.UpstreamAttenuation.FloatValue = interpretExtendedStatsFloatValue(values, "attndb")
 	status.DownstreamSNRMargin.FloatValue, status.UpstreamSNRMargin.FloatValue = interpretExtendedStatsFloatValue(values, "snrdb")
 	status.DownstreamPower.FloatValue, status.UpstreamPower.FloatValue = interpretExtendedStatsFloatValue(values, "pwrdbm")
@@ -228,6 +297,18 @@ func interpretExtendedStats(status *models.Status, values map[string][2]string)
 	status.DownstreamCRCCount, status.UpstreamCRCCount = interpretExtendedStatsIntValue(values, "crc")
 	status.DownstreamESCount, status.UpstreamESCount = interpretExtendedStatsIntValue(values, "es")
 	status.DownstreamSESCount, status.UpstreamSESCount = interpretExtendedStatsIntValue(values, "ses")
+
+	status.DownstreamLOSSCount, status.UpstreamLOSSCount = interpretExtendedStatsIntValue(values, "los")
+	status.DownstreamLOFSCount, status.UpstreamLOFSCount = interpretExtendedStatsIntValue(values, "lof")
+	status.DownstreamUASCount, status.UpstreamUASCount = interpretExtendedStatsIntValue(values, "uas")
+
+	status.Configuration.DownstreamFraming.L, status.Configuration.UpstreamFraming.L = interpretExtendedStatsIntValue(values, "l")
+	status.Configuration.DownstreamFraming.D, status.Configuration.UpstreamFraming.D = interpretExtendedStatsIntValue(values, "d")
+	status.Configuration.DownstreamFraming.N, status.Configuration.UpstreamFraming.N = interpretExtendedStatsIntValue(values, "n")
+	status.Configuration.DownstreamFraming.R, status.Configuration.UpstreamFraming.R = interpretExtendedStatsIntValue(values, "r")
+	status.Configuration.DownstreamFraming.I, status.Configuration.UpstreamFraming.I = interpretExtendedStatsIntValue(values, "i")
+	status.Configuration.DownstreamFraming.M, status.Configuration.UpstreamFraming.M = interpretExtendedStatsIntValue(values, "m")
+	status.Configuration.DownstreamFraming.T, status.Configuration.UpstreamFraming.T = interpretExtendedStatsIntValue(values, "t")
 }
 
 func interpretExtendedStatsIntValue(values map[string][2]string, key string) (downstream, upstream models.IntValue) {
@@ -341,19 +422,44 @@ func parseVectoring(status *models.Status, vectoring string) {
 	for scanner.Scan() {
 		line := scanner.Text()
 
-		if strings.HasPrefix(strings.ToLower(line), "vectoring state:") {
-			state := strings.TrimSpace(strings.Split(line, ":")[1])
+		split := strings.SplitN(line, ":", 2)
+		if len(split) != 2 {
+			continue
+		}
+
+		key := strings.ToLower(regexpFilterCharacters.ReplaceAllString(split[0], ""))
+		val := strings.TrimSpace(split[1])
+
+		switch key {
 
-			if state == "1" || state == "3" {
+		case "vectoringstate":
+			if val == "1" || val == "3" {
 				status.DownstreamVectoringState.State = models.VectoringStateFull
 				status.DownstreamVectoringState.Valid = true
 			}
+			status.Vectoring.MachineState = val
+
+		case "erbsent":
+			status.Vectoring.ErrorSampleCount = parseVectoringCounter(val)
+
+		case "erbdiscarded":
+			status.Vectoring.DiscardedErrorSampleCount = parseVectoringCounter(val)
 
-			break
 		}
 	}
 }
 
+// parseVectoringCounter parses an error sample (ERB) counter, which may be followed by further information
+func parseVectoringCounter(val string) (out models.IntValue) {
+	if fields := strings.Fields(val); len(fields) != 0 {
+		if valInt, err := strconv.ParseInt(fields[0], 10, 64); err == nil {
+			out.Int = valInt
+			out.Valid = true
+		}
+	}
+	return
+}
+
 func parseVendor(status *models.Status, vendor string) {
 	scanner := bufio.NewScanner(strings.NewReader(vendor))
 
@@ -368,6 +474,10 @@ func parseVendor(status *models.Status, vendor string) {
 			version := strings.TrimSpace(strings.Split(line, ":")[1])
 			versionByte := helpers.ParseHexadecimal(version)
 			status.FarEndInventory.Version = helpers.FormatVersion(status.FarEndInventory.Vendor, versionByte)
+			status.FarEndInventory.Model = helpers.FormatModel(status.FarEndInventory.Vendor, versionByte)
+		} else if strings.HasPrefix(lineLower, "chipset serialnumber:") {
+			serialNumber := strings.TrimSpace(strings.SplitN(line, ":", 2)[1])
+			status.FarEndInventory.SerialNumber = helpers.FormatInventoryString([]byte(serialNumber))
 		}
 	}
 }
@@ -392,3 +502,134 @@ func parseVersion(status *models.Status, version string) {
 		}
 	}
 }
+
+// ParseProfile parses the enabled modes and VDSL2 profiles from the output of "xdslctl profile --show".
+func ParseProfile(status *models.Status, profile string) {
+	var section string
+
+	scanner := bufio.NewScanner(strings.NewReader(profile))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if len(line) > 0 && line[0] != ' ' && line[0] != '\t' {
+			section = strings.ToLower(strings.TrimSpace(line))
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 || !strings.EqualFold(fields[1], "enabled") {
+			continue
+		}
+
+		switch {
+
+		case strings.HasPrefix(section, "modulations"):
+			mode := helpers.ParseMode(fields[0])
+			switch strings.ToLower(fields[0]) {
+			case "t1.413":
+				mode = models.Mode{Type: models.ModeTypeADSL}
+			case "annexl":
+				mode = models.Mode{Type: models.ModeTypeADSL2, Subtype: models.ModeSubtypeAnnexL}
+			case "annexm":
+				mode = models.Mode{Type: models.ModeTypeADSL2Plus, Subtype: models.ModeSubtypeAnnexM}
+			}
+			if mode.Type != models.ModeTypeUnknown && !containsMode(status.Configuration.EnabledModes, mode) {
+				status.Configuration.EnabledModes = append(status.Configuration.EnabledModes, mode)
+			}
+
+		case strings.HasPrefix(section, "vdsl2 profiles"):
+			profile := fields[0]
+			if strings.HasSuffix(strings.ToLower(profile), "brcmpriv1") {
+				profile = "35b"
+			}
+			mode := helpers.ParseMode("VDSL2 " + profile)
+			if mode.Subtype != models.ModeSubtypeUnknown {
+				status.Configuration.EnabledProfiles = append(status.Configuration.EnabledProfiles, mode.Subtype)
+			}
+
+		}
+	}
+}
+
+func containsMode(modes []models.Mode, mode models.Mode) bool {
+	for _, m := range modes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseBandStatus parses the per-band values from the "VDSL Band Status" table, which is part of the
+// output of "xdslctl info --pbParams".
+func ParseBandStatus(status *models.Status, pbParams string) {
+	bands := make(map[string]*models.BandStatus)
+	var names []string
+
+	scanner := bufio.NewScanner(strings.NewReader(pbParams))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.Contains(strings.ToLower(line), "band status") {
+			names = nil
+			for _, field := range strings.Fields(line) {
+				if regexpBandName.MatchString(field) {
+					names = append(names, field)
+					bands[field] = &models.BandStatus{Name: field}
+				}
+			}
+			continue
+		}
+
+		if names == nil {
+			continue
+		}
+
+		split := strings.SplitN(line, ":", 2)
+		if len(split) != 2 {
+			continue
+		}
+
+		key := strings.ToLower(regexpFilterCharacters.ReplaceAllString(split[0], ""))
+		valSplit := strings.Fields(split[1])
+
+		for i, val := range valSplit {
+			if i >= len(names) {
+				break
+			}
+
+			var out models.FloatValue
+			if valFloat, err := strconv.ParseFloat(val, 64); err == nil {
+				out.Float = valFloat
+				out.Valid = true
+			}
+
+			band := bands[names[i]]
+			switch key {
+			case "lineattenuationdb":
+				band.Attenuation.FloatValue = out
+			case "signalattenuationdb":
+				band.SignalAttenuation.FloatValue = out
+			case "snrmargindb":
+				band.SNRMargin.FloatValue = out
+			case "txpowerdbm":
+				band.Power.FloatValue = out
+			}
+		}
+	}
+
+	for _, name := range names {
+		band := bands[name]
+		if !band.IsValid() {
+			continue
+		}
+
+		if name[0] == 'U' {
+			status.UpstreamBands = append(status.UpstreamBands, *band)
+		} else {
+			status.DownstreamBands = append(status.DownstreamBands, *band)
+		}
+	}
+}
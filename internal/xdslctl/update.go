@@ -0,0 +1,212 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package xdslctl
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/exec"
+	"3e8.eu/go/dsl/models"
+)
+
+// Commands contains which of the optional sub-commands are supported by the xdslctl version of a device. Each
+// of them is probed once during the first update, so that unsupported commands are not executed again for each
+// following update. The zero value is ready to use.
+type Commands struct {
+	supported map[string]bool
+}
+
+// execute runs an optional sub-command, unless it is already known to be unsupported. Only connection errors are
+// returned, as the support of the command can't be determined in this case.
+func (c *Commands) execute(e exec.Executor, command, subcommand string) (output string, ok bool, err error) {
+	if supported, probed := c.supported[subcommand]; probed && !supported {
+		return
+	}
+
+	output, err = e.Execute(command + " " + subcommand)
+
+	var connErr *dsl.ConnectionError
+	if errors.As(err, &connErr) {
+		return
+	}
+
+	ok = err == nil && !exec.IsCommandNotFound(output, err) && !isUsage(output)
+	err = nil
+
+	if c.supported == nil {
+		c.supported = make(map[string]bool)
+	}
+	c.supported[subcommand] = ok
+
+	return
+}
+
+// isUsage checks whether xdslctl printed its usage information instead of data, which happens for unknown
+// options if the exit status is not available (e.g. via telnet)
+func isUsage(output string) bool {
+	firstLine := strings.SplitN(strings.TrimSpace(output), "\n", 2)[0]
+	return strings.HasPrefix(strings.ToLower(firstLine), "usage")
+}
+
+func UpdateData(e exec.Executor, command string, commands *Commands) (status models.Status, bins models.Bins, rawData []byte, err error) {
+	if command == "" {
+		command = "xdslctl"
+	}
+
+	stats, err := e.Execute(command + " info --stats")
+	if exec.IsCommandNotFound(stats, err) {
+		err = errors.New("command not found, check the configuration")
+		return
+	} else if err != nil {
+		return
+	}
+
+	vendor, err := e.Execute(command + " info --vendor")
+	if err != nil {
+		return
+	}
+
+	version, err := e.Execute(command + " --version")
+	if err != nil {
+		return
+	}
+
+	bits, err := e.Execute(command + " info --Bits")
+	if err != nil {
+		return
+	}
+
+	snr, err := e.Execute(command + " info --SNR")
+	if err != nil {
+		return
+	}
+
+	qln, err := e.Execute(command + " info --QLN")
+	if err != nil {
+		return
+	}
+
+	hlog, err := e.Execute(command + " info --Hlog")
+	if err != nil {
+		return
+	}
+
+	// the following commands are not supported by all versions or devices, and only used if available
+	optional := make(map[string]string)
+	for _, subcommand := range []string{
+		"info --vectoring",
+		"profile --show",
+		"info --pbParams",
+		"info --UPBO",
+		"info --linediag",
+		"info --gains",
+		"info --Hlin",
+	} {
+		var output string
+		var ok bool
+		output, ok, err = commands.execute(e, command, subcommand)
+		if err != nil {
+			return
+		}
+		if ok {
+			optional[subcommand] = output
+		}
+	}
+
+	vectoring := optional["info --vectoring"]
+	pbParams := optional["info --pbParams"]
+
+	status = ParseStatus(stats, vectoring, vendor, version)
+	ParseProfile(&status, optional["profile --show"])
+	ParseBandStatus(&status, pbParams)
+	ParsePowerBackOff(&status, optional["info --UPBO"])
+	bins = ParseBins(status, pbParams, bits, snr, qln, hlog)
+	ParsePSD(&bins, optional["info --linediag"])
+	ParseFEXTCancellation(&bins, vectoring)
+	ParseGains(&bins, optional["info --gains"])
+	ParseHlin(&bins, optional["info --Hlin"])
+
+	var b strings.Builder
+	writeOutput := func(subcommand, output string) {
+		fmt.Fprintln(&b, "# xdslctl "+subcommand)
+		fmt.Fprintln(&b, output)
+	}
+	writeOptionalOutput := func(subcommand string) {
+		if output, ok := optional[subcommand]; ok {
+			writeOutput(subcommand, output)
+		}
+	}
+	writeOutput("info --stats", stats)
+	writeOptionalOutput("info --vectoring")
+	writeOutput("info --vendor", vendor)
+	writeOutput("--version", version)
+	writeOptionalOutput("profile --show")
+	writeOptionalOutput("info --pbParams")
+	writeOutput("info --Bits", bits)
+	writeOutput("info --SNR", snr)
+	writeOutput("info --QLN", qln)
+	writeOutput("info --Hlog", hlog)
+	writeOptionalOutput("info --UPBO")
+	writeOptionalOutput("info --linediag")
+	writeOptionalOutput("info --gains")
+	writeOptionalOutput("info --Hlin")
+	fmt.Fprintln(&b)
+	rawData = []byte(b.String())
+
+	return
+}
+
+// secondLineCommand returns the command for the second line of bonded connections, which uses a separate command
+// with the suffix "1" (e.g. xdslctl1) that only exists on such devices
+func secondLineCommand(command string) string {
+	if command == "" {
+		command = "xdslctl"
+	}
+	return command + "1"
+}
+
+// StatsMarker is contained in the output of "xdslctl info --stats"
+const StatsMarker = "Status:"
+
+// HasSecondLine checks whether the device supports a second line. This only needs to be done once after
+// connecting to the device. As some shells print errors for unknown commands without a failing exit code,
+// the output needs to contain actual statistics.
+func HasSecondLine(e exec.Executor, command string) bool {
+	stats, err := e.Execute(secondLineCommand(command) + " info --stats")
+	return err == nil && !exec.IsCommandNotFound(stats, err) && strings.Contains(stats, StatsMarker)
+}
+
+// UpdateDataLines reads the data of all lines. If the device has a second line (as determined by HasSecondLine),
+// a failure to read it does not discard the data of the first line. Instead, the line is included with an unknown
+// state and the error is noted in the raw data. The supported commands are kept separately for each line.
+func UpdateDataLines(e exec.Executor, command string, secondLine bool, commands *[2]Commands) (lines []models.Line, rawData []byte, err error) {
+	status, bins, rawData, err := UpdateData(e, command, &commands[0])
+	if err != nil {
+		return
+	}
+	lines = append(lines, models.Line{Status: status, Bins: bins})
+
+	if !secondLine {
+		return
+	}
+
+	commandSecondLine := secondLineCommand(command)
+	rawData = append(rawData, []byte("# line 2 ("+commandSecondLine+")\n\n")...)
+
+	status, bins, rawDataSecondLine, errSecondLine := UpdateData(e, commandSecondLine, &commands[1])
+	if errSecondLine != nil {
+		lines = append(lines, models.Line{})
+		rawData = append(rawData, []byte(fmt.Sprintf("# error: %s\n\n", errSecondLine))...)
+		return
+	}
+	lines = append(lines, models.Line{Status: status, Bins: bins})
+
+	rawData = append(rawData, rawDataSecondLine...)
+
+	return
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at https://mozilla.org/MPL/2.0/.
 
-package sagemcom
+package xmo
 
 import (
 	"crypto/md5"
@@ -27,7 +27,8 @@ import (
 var regexpConfigurationSHA512 = regexp.MustCompile(`GUI_ACTIVATE_SHA512ENCODE_OPT:\s?([0-9]+)`)
 var regexpConfigurationSalt = regexp.MustCompile(`GUI_PASSWORD_SALT:\s?("(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*')`)
 
-type session struct {
+// Session is a logged in session of the XMO JSON API used by Sagemcom devices.
+type Session struct {
 	host           string
 	username       string
 	password       string
@@ -153,8 +154,8 @@ const (
 	xmoNoError             = "XMO_NO_ERR"
 )
 
-func newSession(host, username string, passwordCallback dsl.PasswordCallback, tlsSkipVerify bool) (*session, error) {
-	s := session{}
+func NewSession(host, username string, passwordCallback dsl.PasswordCallback, tlsSkipVerify bool) (*Session, error) {
+	s := Session{}
 	s.username = username
 
 	if !strings.HasPrefix(host, "http://") && !strings.HasPrefix(host, "https://") {
@@ -188,7 +189,7 @@ func newSession(host, username string, passwordCallback dsl.PasswordCallback, tl
 	return &s, nil
 }
 
-func (s *session) createHTTPClient(tlsSkipVerify bool) {
+func (s *Session) createHTTPClient(tlsSkipVerify bool) {
 	transport := &http.Transport{
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: tlsSkipVerify},
 	}
@@ -202,7 +203,7 @@ func (s *session) createHTTPClient(tlsSkipVerify bool) {
 	}
 }
 
-func (s *session) unquote(str string) string {
+func (s *Session) unquote(str string) string {
 	str = str[1 : len(str)-1]
 
 	var escaped bool
@@ -219,7 +220,7 @@ func (s *session) unquote(str string) string {
 	return str
 }
 
-func (s *session) loadConfiguration() error {
+func (s *Session) loadConfiguration() error {
 	guiCoreJS, err := s.get("/js/gui-core.js")
 	if err != nil {
 		return err
@@ -238,7 +239,7 @@ func (s *session) loadConfiguration() error {
 	return nil
 }
 
-func (s *session) login() error {
+func (s *Session) login() error {
 	actions := []xmoRequestAction{
 		xmoRequestAction{
 			ID:     0,
@@ -303,7 +304,7 @@ func (s *session) login() error {
 	return nil
 }
 
-func (s *session) loadValue(xpath string) ([]byte, error) {
+func (s *Session) LoadValue(xpath string) ([]byte, error) {
 	actions := []xmoRequestAction{
 		xmoRequestAction{
 			ID:     0,
@@ -353,7 +354,7 @@ func (s *session) loadValue(xpath string) ([]byte, error) {
 	return parameters.Value, nil
 }
 
-func (s *session) doRequest(actions []xmoRequestAction, isLogin bool) (*xmoReply, error) {
+func (s *Session) doRequest(actions []xmoRequestAction, isLogin bool) (*xmoReply, error) {
 	request, err := s.buildRequest(actions)
 	if err != nil {
 		return nil, err
@@ -371,7 +372,7 @@ func (s *session) doRequest(actions []xmoRequestAction, isLogin bool) (*xmoReply
 	return reply, err
 }
 
-func (s *session) generateNonce() (uint32, error) {
+func (s *Session) generateNonce() (uint32, error) {
 	nonceBytes := make([]byte, 4)
 
 	_, err := rand.Read(nonceBytes)
@@ -382,7 +383,7 @@ func (s *session) generateNonce() (uint32, error) {
 	return binary.LittleEndian.Uint32(nonceBytes), nil
 }
 
-func (s *session) hash(data []byte) []byte {
+func (s *Session) hash(data []byte) []byte {
 	if s.useSHA512 {
 		h := sha512.Sum512(data)
 		return h[:]
@@ -392,14 +393,14 @@ func (s *session) hash(data []byte) []byte {
 	return h[:]
 }
 
-func (s *session) hashPassword() []byte {
+func (s *Session) hashPassword() []byte {
 	if s.salt != "" {
 		return s.hash([]byte(s.password + ":" + s.salt))
 	}
 	return s.hash([]byte(s.password))
 }
 
-func (s *session) buildRequest(actions []xmoRequestAction) (string, error) {
+func (s *Session) buildRequest(actions []xmoRequestAction) (string, error) {
 	requestID := s.requestCounter
 	s.requestCounter++
 
@@ -431,13 +432,13 @@ func (s *session) buildRequest(actions []xmoRequestAction) (string, error) {
 	return string(requestJSON), err
 }
 
-func (s *session) parseReply(data []byte) (*xmoReply, error) {
+func (s *Session) parseReply(data []byte) (*xmoReply, error) {
 	var replyWrapper xmoReplyWrapper
 	err := json.Unmarshal(data, &replyWrapper)
 	return &replyWrapper.Reply, err
 }
 
-func (s *session) get(path string) ([]byte, error) {
+func (s *Session) get(path string) ([]byte, error) {
 	resp, err := s.client.Get(s.host + path)
 	if err != nil {
 		return nil, err
@@ -452,7 +453,7 @@ func (s *session) get(path string) ([]byte, error) {
 	return body, err
 }
 
-func (s *session) postForm(path string, data url.Values, isLogin bool) ([]byte, error) {
+func (s *Session) postForm(path string, data url.Values, isLogin bool) ([]byte, error) {
 	client := s.client
 	if isLogin {
 		client = s.clientLogin
@@ -472,7 +473,7 @@ func (s *session) postForm(path string, data url.Values, isLogin bool) ([]byte,
 	return body, err
 }
 
-func (s *session) close() {
+func (s *Session) Close() {
 	if s.sessionID == "" {
 		return
 	}
@@ -56,9 +56,18 @@ func NewClient(config Config) (dsl.Client, error) {
 		return nil, err
 	}
 
-	c.client, err = snmp.NewClient(config.Host, "udp", config.User,
-		snmp.AuthProtocol(config.AuthProtocol), snmp.PrivacyProtocol(config.PrivacyProtocol),
-		config.Password, config.EncryptionPassphrase)
+	version := snmp.Version(config.Version)
+	if version == "" {
+		version = snmp.Version3
+	}
+
+	if version == snmp.Version3 {
+		c.client, err = snmp.NewClient(config.Host, "udp", config.User,
+			snmp.AuthProtocol(config.AuthProtocol), snmp.PrivacyProtocol(config.PrivacyProtocol),
+			config.Password, config.EncryptionPassphrase)
+	} else {
+		c.client, err = snmp.NewClientCommunity(config.Host, "udp", version, config.Community)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,8 @@ type Config struct {
 	User                 string
 	Password             dsl.PasswordCallback
 	EncryptionPassphrase dsl.EncryptionPassphraseCallback
+	Version              string
+	Community            string
 	AuthProtocol         string
 	PrivacyProtocol      string
 	Subtree              string
@@ -10,6 +10,19 @@ import (
 )
 
 func init() {
+	subtreeOption := dsl.Option{
+		Description: "the LCOS subtree to load data from",
+		Type:        dsl.OptionTypeEnum,
+		Values: []dsl.OptionValue{
+			dsl.OptionValue{Value: "", Title: "auto-detect"},
+			dsl.OptionValue{Value: "/Status/VDSL", Title: "Status > VDSL"},
+			dsl.OptionValue{Value: "/Status/xDSL/VDSL1", Title: "Status > xDSL > VDSL1"},
+			dsl.OptionValue{Value: "/Status/xDSL/VDSL2", Title: "Status > xDSL > VDSL2"},
+			dsl.OptionValue{Value: "/Status/ADSL", Title: "Status > ADSL"},
+			dsl.OptionValue{Value: "/Status/xDSL/ADSL", Title: "Status > xDSL > ADSL"},
+		},
+	}
+
 	newFunc := func(config dsl.Config) (dsl.Client, error) {
 		clientConfig := Config{
 			Host:                 config.Host,
@@ -46,19 +59,37 @@ func init() {
 				snmp.PrivacyProtocolAES256,
 				snmp.PrivacyProtocolAES256C,
 			),
-			"Subtree": dsl.Option{
-				Description: "the LCOS subtree to load data from",
-				Type:        dsl.OptionTypeEnum,
-				Values: []dsl.OptionValue{
-					dsl.OptionValue{Value: "", Title: "auto-detect"},
-					dsl.OptionValue{Value: "/Status/VDSL", Title: "Status > VDSL"},
-					dsl.OptionValue{Value: "/Status/xDSL/VDSL1", Title: "Status > xDSL > VDSL1"},
-					dsl.OptionValue{Value: "/Status/xDSL/VDSL2", Title: "Status > xDSL > VDSL2"},
-					dsl.OptionValue{Value: "/Status/ADSL", Title: "Status > ADSL"},
-					dsl.OptionValue{Value: "/Status/xDSL/ADSL", Title: "Status > xDSL > ADSL"},
-				},
-			},
+			"Subtree": subtreeOption,
 		},
 	}
 	dsl.RegisterClient("lancom_snmpv3", newFunc, clientDesc)
+
+	newCommunity := func(config dsl.Config) (dsl.Client, error) {
+		clientConfig := Config{
+			Host:      config.Host,
+			Version:   config.Options["Version"],
+			Community: config.Options["Community"],
+			Subtree:   config.Options["Subtree"],
+		}
+		if clientConfig.Version == "" {
+			clientConfig.Version = snmp.Version2c
+		}
+		return NewClient(clientConfig)
+	}
+	clientDescCommunity := dsl.ClientDesc{
+		Title:        "LANCOM (SNMPv1/v2c)",
+		RequiresUser: dsl.TristateNo,
+		Options: map[string]dsl.Option{
+			"Version": snmp.GetVersionOption(
+				snmp.Version2c,
+				snmp.Version1,
+			),
+			"Community": dsl.Option{
+				Description: "community string (default: " + snmp.DefaultCommunity + ")",
+				Type:        dsl.OptionTypeString,
+			},
+			"Subtree": subtreeOption,
+		},
+	}
+	dsl.RegisterClient("lancom_snmp", newCommunity, clientDescCommunity)
 }
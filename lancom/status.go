@@ -162,6 +162,7 @@ func interpretMode(values snmp.Values, oidStandard, oidLineType, oidProfile stri
 func interpretNearEndInventory(values snmp.Values, oidChipset, oidVersion string) (out models.Inventory) {
 	out.Vendor = "LANCOM"
 	if val, err := values.GetString(oidChipset); err == nil {
+		out.Model = strings.TrimSpace(val)
 		if strings.HasPrefix(val, "Lantiq") || strings.HasPrefix(val, "Ifx") || strings.Contains(val, "VINAX") {
 			out.Vendor = "Infineon"
 		}
@@ -172,12 +173,11 @@ func interpretNearEndInventory(values snmp.Values, oidChipset, oidVersion string
 	return
 }
 
+// interpretFarEndInventory decodes the DSLAM chipset dump, which starts with the G.994.1 vendor ID of the DSLAM
+// (including the firmware version, from which the chipset model is derived if it is known)
 func interpretFarEndInventory(values snmp.Values, oid string) (out models.Inventory) {
-	if val, err := values.GetBytes(oid); err == nil {
-		if len(val) == 8 {
-			out.Vendor = helpers.FormatVendor(string(val[2:6]))
-			out.Version = helpers.FormatVersion(out.Vendor, val[6:8])
-		}
+	if val, err := values.GetBytes(oid); err == nil && len(val) >= 8 {
+		helpers.InterpretVendorID(&out, val[:8])
 	}
 	return
 }
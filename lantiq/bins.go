@@ -45,6 +45,15 @@ func parseBins(status *models.Status, data *data) models.Bins {
 
 	fixHlogScaling(status, &bins.Hlog.Downstream)
 
+	parseDELTHlin(&bins.Hlin.Upstream, data.G997_DeltHLIN_US)
+	parseDELTHlin(&bins.Hlin.Downstream, data.G997_DeltHLIN_DS)
+
+	parseGainAllocation(&bins.Gains.Upstream, data.G997_GainAllocationNscShort_US)
+	parseGainAllocation(&bins.Gains.Downstream, data.G997_GainAllocationNscShort_DS)
+
+	parsePSDMask(&bins.PSD.Upstream, bins.Mode.BinCount(), bins.Bands.Upstream, data.G997_PsdMaskStatus_US)
+	parsePSDMask(&bins.PSD.Downstream, bins.Mode.BinCount(), bins.Bands.Downstream, data.G997_PsdMaskStatus_DS)
+
 	return bins
 }
 
@@ -134,6 +143,31 @@ func parseSNRAllocation(out *models.BinsFloat, data dataItem) {
 	out.Data = parseBinsHelper(rawValues, 16, 8, 255, -32, 2)
 }
 
+func parseGainAllocation(out *models.BinsFloat, data dataItem) {
+	rawValues := parseBinsShort(data.Output)
+
+	var found bool
+	values := make([]float64, len(rawValues))
+
+	for num, val := range rawValues {
+		valInt, err := strconv.ParseInt(val, 16, 32)
+		if err != nil {
+			valInt = 0
+		}
+		if valInt > 0 {
+			found = true
+		}
+		values[num] = helpers.ConvertGain(valInt)
+	}
+
+	if !found {
+		return
+	}
+
+	out.GroupSize = 1
+	out.Data = values
+}
+
 func parseBinsDELT(data string, bands []models.Band) (rawItems []string, groupSize int) {
 	v := parseValues(data)
 
@@ -200,6 +234,90 @@ func parseDELTHlog(out *models.BinsFloat, bands []models.Band, data dataItem) {
 	out.Data = parseBinsHelper(rawValues, 10, 10, 1023, 6, -10)
 }
 
+// parsePSDMask reads the breakpoints of the PSD mask, with the PSD level given in steps of -0.1 dBm/Hz.
+func parsePSDMask(out *models.BinsFloat, binCount int, bands []models.Band, data dataItem) {
+	v := parseValues(data.Output)
+	items := strings.Fields(v["nData"])
+
+	var breakpoints []helpers.PSDBreakpoint
+
+	for _, item := range items {
+		if len(item) < 2 || item[0] != '(' || item[len(item)-1] != ')' {
+			continue
+		}
+
+		itemSplit := strings.SplitN(item[1:len(item)-1], ",", 2)
+		if len(itemSplit) != 2 {
+			continue
+		}
+
+		bin, err := strconv.Atoi(itemSplit[0])
+		if err != nil {
+			continue
+		}
+
+		level, err := strconv.Atoi(itemSplit[1])
+		if err != nil || level <= 0 || level > 1500 {
+			continue
+		}
+
+		breakpoints = append(breakpoints, helpers.PSDBreakpoint{Bin: bin, PSD: -float64(level) / 10})
+	}
+
+	helpers.GeneratePSDData(out, binCount, bands, breakpoints)
+}
+
+// parseDELTHlin reads the complex channel characteristic, the values are scaled according to G.997.1.
+func parseDELTHlin(out *models.BinsComplex, data dataItem) {
+	v := parseValues(data.Output)
+
+	numData, err := strconv.Atoi(v["nNumData"])
+	if err != nil || numData == 0 {
+		return
+	}
+
+	groupSize, err := strconv.Atoi(v["nGroupSize"])
+	if err != nil || groupSize == 0 {
+		return
+	}
+
+	scale, err := strconv.ParseFloat(v["nDeltHlinScale"], 64)
+	if err != nil || scale == 0 {
+		return
+	}
+	factor := scale / (1 << 30)
+
+	out.GroupSize = groupSize
+	out.Real = make([]float64, numData)
+	out.Imag = make([]float64, numData)
+
+	for _, item := range strings.Fields(v["nData"]) {
+		if len(item) < 2 || item[0] != '(' || item[len(item)-1] != ')' {
+			continue
+		}
+
+		itemSplit := strings.Split(item[1:len(item)-1], ",")
+		if len(itemSplit) != 3 {
+			continue
+		}
+
+		num, err := strconv.Atoi(itemSplit[0])
+		if err != nil || num >= numData {
+			continue
+		}
+
+		valReal, errReal := strconv.ParseInt(itemSplit[1], 10, 32)
+		valImag, errImag := strconv.ParseInt(itemSplit[2], 10, 32)
+		// both parts set to -2^15 indicate that the value is not available
+		if errReal != nil || errImag != nil || (valReal == -32768 && valImag == -32768) {
+			continue
+		}
+
+		out.Real[num] = float64(valReal) * factor
+		out.Imag[num] = float64(valImag) * factor
+	}
+}
+
 func parseBinsHelper(rawValues []string, base, bitSize int, invalid uint64, offset, divisor float64) (out []float64) {
 	out = make([]float64, len(rawValues))
 
@@ -9,17 +9,33 @@ import (
 )
 
 type TelnetConfig struct {
-	Host     string
-	User     string
-	Password dsl.PasswordCallback
-	Command  string
+	Host           string
+	User           string
+	Password       dsl.PasswordCallback
+	Command        string
+	LineCount      int
+	Serial         bool
+	BaudRate       int
+	AccountPrompt  string
+	PasswordPrompt string
+	CommandPrompt  string
+	PreCommands    []string
 }
 
 type SSHConfig struct {
-	Host        string
-	User        string
-	Password    dsl.PasswordCallback
-	PrivateKeys dsl.PrivateKeysCallback
-	KnownHosts  string
-	Command     string
+	Host           string
+	User           string
+	Password       dsl.PasswordCallback
+	PrivateKeys    dsl.PrivateKeysCallback
+	SSHAgent       bool
+	KnownHosts     string
+	UnknownHostKey dsl.UnknownHostKeyCallback
+	JumpHost       *dsl.JumpHostConfig
+	Command        string
+	LineCount      int
+}
+
+type LocalConfig struct {
+	Command   string
+	LineCount int
 }
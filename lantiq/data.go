@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"3e8.eu/go/dsl/internal/exec"
@@ -22,46 +23,67 @@ type data struct {
 	Command    string
 	APIVersion string
 
+	// for devices with multiple lines, the line number is passed as first parameter of all commands
+	// (legacy commands always include it already)
+	MultiLine bool
+	Line      int
+
 	LineState                    dataItem `command:"lsg" commandLegacy:"lsg 0"`
 	G997_XTUSystemEnablingStatus dataItem `command:"g997xtusesg" commandLegacy:"g997atusesg 0"`
+	G997_XTUSystemEnablingConfig dataItem `command:"g997xtusecg" commandLegacy:"g997atusecg 0"`
 	BandPlanSTatus               dataItem `command:"bpstg" commandLegacy:"bpcg 0"`
 	VersionInformation           dataItem
+	G997_LineInventory_Near      dataItem `command:"g997lig 0" commandLegacy:"g997lig 0 0"`
 	G997_LineInventory_Far       dataItem `command:"g997lig 1" commandLegacy:"g997lig 0 1"`
 
 	G997_ChannelStatus_US        dataItem `command:"g997csg 0 0" commandLegacy:"g997csg 0 0 0"`
 	G997_ChannelStatus_DS        dataItem `command:"g997csg 0 1" commandLegacy:"g997csg 0 0 1"`
 	G997_LineStatus_US           dataItem `command:"g997lsg 0 1" commandLegacy:"g997lsg 0 0"`
 	G997_LineStatus_DS           dataItem `command:"g997lsg 1 1" commandLegacy:"g997lsg 0 1"`
+	G997_LineStatusPerBand_US    dataItem `command:"g997lspbg 0"`
+	G997_LineStatusPerBand_DS    dataItem `command:"g997lspbg 1"`
 	LineFeatureStatus_US         dataItem `command:"lfsg 0"`
 	LineFeatureStatus_DS         dataItem `command:"lfsg 1"`
+	FramingParameterStatus_US    dataItem `command:"fpsg 0 0"`
+	FramingParameterStatus_DS    dataItem `command:"fpsg 1 0"`
+	TcStatus                     dataItem `command:"tsg"`
 	G997_RateAdaptationStatus_US dataItem `command:"g997rasg 0"`
 	G997_RateAdaptationStatus_DS dataItem `command:"g997rasg 1"`
 	OlrStatistics_US             dataItem `command:"osg 0" commandLegacy:"ostg 0 0"`
 	OlrStatistics_DS             dataItem `command:"osg 1" commandLegacy:"ostg 0 1"`
 	DSM_Status                   dataItem `command:"dsmsg"`
+	DSM_Statistics               dataItem `command:"dsmstatg"`
+	G997_UsPowerBackOffStatus    dataItem `command:"g997upbosg"`
 
 	PM_ChannelCountersShowtime_Near dataItem `command:"pmccsg 0 0 0,pmcctg 0 0" commandLegacy:"pmcctg 0 0 0"`
 	PM_ChannelCountersShowtime_Far  dataItem `command:"pmccsg 0 1 0,pmcctg 0 1" commandLegacy:"pmcctg 0 0 1"`
 	PM_LineSecCountersShowtime_Near dataItem `command:"pmlscsg 0 0,pmlsctg 0" commandLegacy:"pmlsctg 0 0"`
 	PM_LineSecCountersShowtime_Far  dataItem `command:"pmlscsg 1 0,pmlsctg 1" commandLegacy:"pmlsctg 0 1"`
+	PM_LineInitCountersTotal        dataItem `command:"pmlictg"`
 	PM_ReTxCountersShowtimeGet_Near dataItem `command:"pmrtcsg 0 0,pmrtctg 0"`
 	PM_ReTxCountersShowtimeGet_Far  dataItem `command:"pmrtcsg 1 0,pmrtctg 1"`
 	ReTxStatistics_Near             dataItem `command:"rtsg 0"`
 	ReTxStatistics_Far              dataItem `command:"rtsg 1"`
 
-	PilotTonesStatus              dataItem `command:"ptsg"`
-	BandBorderStatus_US           dataItem `command:"bbsg 0"`
-	BandBorderStatus_DS           dataItem `command:"bbsg 1"`
-	G997_BitAllocationNscShort_US dataItem `command:"g997bansg 0" commandLegacy:"g997banscsg 0 0"`
-	G997_BitAllocationNscShort_DS dataItem `command:"g997bansg 1" commandLegacy:"g997banscsg 0 1"`
-	G997_SnrAllocationNscShort_US dataItem `command:"g997sansg 0" commandLegacy:"g997snrnscsg 0 0"`
-	G997_SnrAllocationNscShort_DS dataItem `command:"g997sansg 1" commandLegacy:"g997snrnscsg 0 1"`
-	G997_DeltSNR_US               dataItem `command:"g997dsnrg 0 1" commandLegacy:"g997dsnrg 0 0"`
-	G997_DeltSNR_DS               dataItem `command:"g997dsnrg 1 1" commandLegacy:"g997dsnrg 0 1"`
-	G997_DeltQLN_US               dataItem `command:"g997dqlng 0 1" commandLegacy:"g997dqlng 0 0"`
-	G997_DeltQLN_DS               dataItem `command:"g997dqlng 1 1" commandLegacy:"g997dqlng 0 1"`
-	G997_DeltHLOG_US              dataItem `command:"g997dhlogg 0 1" commandLegacy:"g997dhlogg 0 0"`
-	G997_DeltHLOG_DS              dataItem `command:"g997dhlogg 1 1" commandLegacy:"g997dhlogg 0 1"`
+	PilotTonesStatus               dataItem `command:"ptsg"`
+	BandBorderStatus_US            dataItem `command:"bbsg 0"`
+	BandBorderStatus_DS            dataItem `command:"bbsg 1"`
+	G997_BitAllocationNscShort_US  dataItem `command:"g997bansg 0" commandLegacy:"g997banscsg 0 0"`
+	G997_BitAllocationNscShort_DS  dataItem `command:"g997bansg 1" commandLegacy:"g997banscsg 0 1"`
+	G997_GainAllocationNscShort_US dataItem `command:"g997gansg 0"`
+	G997_GainAllocationNscShort_DS dataItem `command:"g997gansg 1"`
+	G997_SnrAllocationNscShort_US  dataItem `command:"g997sansg 0" commandLegacy:"g997snrnscsg 0 0"`
+	G997_SnrAllocationNscShort_DS  dataItem `command:"g997sansg 1" commandLegacy:"g997snrnscsg 0 1"`
+	G997_DeltSNR_US                dataItem `command:"g997dsnrg 0 1" commandLegacy:"g997dsnrg 0 0"`
+	G997_DeltSNR_DS                dataItem `command:"g997dsnrg 1 1" commandLegacy:"g997dsnrg 0 1"`
+	G997_DeltQLN_US                dataItem `command:"g997dqlng 0 1" commandLegacy:"g997dqlng 0 0"`
+	G997_DeltQLN_DS                dataItem `command:"g997dqlng 1 1" commandLegacy:"g997dqlng 0 1"`
+	G997_DeltHLOG_US               dataItem `command:"g997dhlogg 0 1" commandLegacy:"g997dhlogg 0 0"`
+	G997_DeltHLOG_DS               dataItem `command:"g997dhlogg 1 1" commandLegacy:"g997dhlogg 0 1"`
+	G997_DeltHLIN_US               dataItem `command:"g997dhling 0 1"`
+	G997_DeltHLIN_DS               dataItem `command:"g997dhling 1 1"`
+	G997_PsdMaskStatus_US          dataItem `command:"g997pmsg 0"`
+	G997_PsdMaskStatus_DS          dataItem `command:"g997pmsg 1"`
 }
 
 func (d *data) LoadData(e exec.Executor, command string) error {
@@ -134,7 +156,8 @@ func (d *data) parseVersionInformation() error {
 
 func (d *data) readData(e exec.Executor) (err error) {
 	tagName := "command"
-	if strings.HasPrefix(d.APIVersion, "2") {
+	isLegacy := strings.HasPrefix(d.APIVersion, "2")
+	if isLegacy {
 		tagName = "commandLegacy"
 	}
 
@@ -153,6 +176,14 @@ func (d *data) readData(e exec.Executor) (err error) {
 
 			commandsSplit := strings.Split(commands, ",")
 			for _, cmd := range commandsSplit {
+				if d.MultiLine {
+					if isLegacy {
+						cmd = replaceLineParameter(cmd, d.Line)
+					} else {
+						cmd = insertLineParameter(cmd, d.Line)
+					}
+				}
+
 				fullCommand = d.Command + " " + cmd
 				out, err = e.Execute(fullCommand)
 				if err != nil {
@@ -181,6 +212,24 @@ func (d *data) readData(e exec.Executor) (err error) {
 	return nil
 }
 
+func insertLineParameter(cmd string, line int) string {
+	name, params := cmd, ""
+	if index := strings.IndexByte(cmd, ' '); index != -1 {
+		name, params = cmd[:index], cmd[index:]
+	}
+	return name + " " + strconv.Itoa(line) + params
+}
+
+// replaceLineParameter sets the line number for legacy commands, which always include it as first parameter
+func replaceLineParameter(cmd string, line int) string {
+	fields := strings.Fields(cmd)
+	if len(fields) < 2 {
+		return insertLineParameter(cmd, line)
+	}
+	fields[1] = strconv.Itoa(line)
+	return strings.Join(fields, " ")
+}
+
 func (d *data) RawData() []byte {
 	var b strings.Builder
 
@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package lantiq
+
+import (
+	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/exec"
+	"3e8.eu/go/dsl/models"
+)
+
+type localClient struct {
+	command   string
+	lineCount int
+	executor  exec.LocalExecutor
+	rawData   []byte
+	status    models.Status
+	bins      models.Bins
+	lines     []models.Line
+}
+
+func NewLocalClient(config LocalConfig) (dsl.Client, error) {
+	c := localClient{}
+	c.command = config.Command
+	c.lineCount = config.LineCount
+
+	return &c, nil
+}
+
+func (c *localClient) RawData() []byte {
+	return c.rawData
+}
+
+func (c *localClient) Status() models.Status {
+	return c.status
+}
+
+func (c *localClient) Bins() models.Bins {
+	return c.bins
+}
+
+func (c *localClient) Lines() []models.Line {
+	return c.lines
+}
+
+func (c *localClient) UpdateData() (err error) {
+	c.lines, c.rawData, err = updateData(&c.executor, c.command, c.lineCount)
+	if err == nil {
+		c.status, c.bins = c.lines[0].Status, c.lines[0].Bins
+	}
+	return
+}
+
+func (c *localClient) Close() {
+}
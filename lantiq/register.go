@@ -5,7 +5,10 @@
 package lantiq
 
 import (
+	"strconv"
+
 	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/telnet"
 )
 
 func init() {
@@ -14,14 +17,36 @@ func init() {
 			Description: "name of the dsl_cpe_pipe command on the device",
 			Type:        dsl.OptionTypeString,
 		},
+		"LineCount": dsl.Option{
+			Description: "number of lines for devices with bonding support, passed as first parameter to all commands",
+			Type:        dsl.OptionTypeString,
+		},
+	}
+
+	telnetOptions := map[string]dsl.Option{
+		"Command":        options["Command"],
+		"LineCount":      options["LineCount"],
+		"Serial":         telnet.GetSerialOption(),
+		"BaudRate":       telnet.GetBaudRateOption(),
+		"AccountPrompt":  telnet.GetAccountPromptOption(),
+		"PasswordPrompt": telnet.GetPasswordPromptOption(),
+		"CommandPrompt":  telnet.GetCommandPromptOption(),
+		"PreCommands":    telnet.GetPreCommandsOption(),
 	}
 
 	newTelnet := func(config dsl.Config) (dsl.Client, error) {
 		telnetConfig := TelnetConfig{
-			Host:     config.Host,
-			User:     config.User,
-			Password: config.AuthPassword,
-			Command:  config.Options["Command"],
+			Host:           config.Host,
+			User:           config.User,
+			Password:       config.AuthPassword,
+			Command:        config.Options["Command"],
+			LineCount:      parseLineCount(config.Options["LineCount"]),
+			Serial:         config.Options["Serial"] == "1",
+			BaudRate:       telnet.ParseBaudRate(config.Options["BaudRate"]),
+			AccountPrompt:  config.Options["AccountPrompt"],
+			PasswordPrompt: config.Options["PasswordPrompt"],
+			CommandPrompt:  config.Options["CommandPrompt"],
+			PreCommands:    telnet.ParsePreCommands(config.Options["PreCommands"]),
 		}
 		return NewTelnetClient(telnetConfig)
 	}
@@ -29,27 +54,55 @@ func init() {
 		Title:              "Lantiq (Telnet)",
 		RequiresUser:       dsl.TristateMaybe,
 		SupportedAuthTypes: dsl.AuthTypePassword,
-		Options:            options,
+		Options:            telnetOptions,
 	}
 	dsl.RegisterClient("lantiq_telnet", newTelnet, clientDescTelnet)
 
 	newSSH := func(config dsl.Config) (dsl.Client, error) {
 		sshConfig := SSHConfig{
-			Host:        config.Host,
-			User:        config.User,
-			Password:    config.AuthPassword,
-			PrivateKeys: config.AuthPrivateKeys,
-			KnownHosts:  config.KnownHosts,
-			Command:     config.Options["Command"],
+			Host:           config.Host,
+			User:           config.User,
+			Password:       config.AuthPassword,
+			PrivateKeys:    config.AuthPrivateKeys,
+			SSHAgent:       config.AuthSSHAgent,
+			KnownHosts:     config.KnownHosts,
+			UnknownHostKey: config.UnknownHostKey,
+			JumpHost:       config.JumpHost,
+			Command:        config.Options["Command"],
+			LineCount:      parseLineCount(config.Options["LineCount"]),
 		}
 		return NewSSHClient(sshConfig)
 	}
 	clientDescSSH := dsl.ClientDesc{
 		Title:              "Lantiq (SSH)",
 		RequiresUser:       dsl.TristateYes,
-		SupportedAuthTypes: dsl.AuthTypePassword | dsl.AuthTypePrivateKeys,
+		SupportedAuthTypes: dsl.AuthTypePassword | dsl.AuthTypePrivateKeys | dsl.AuthTypeSSHAgent,
 		RequiresKnownHosts: true,
+		SupportsJumpHost:   true,
 		Options:            options,
 	}
 	dsl.RegisterClient("lantiq_ssh", newSSH, clientDescSSH)
+
+	newLocal := func(config dsl.Config) (dsl.Client, error) {
+		localConfig := LocalConfig{
+			Command:   config.Options["Command"],
+			LineCount: parseLineCount(config.Options["LineCount"]),
+		}
+		return NewLocalClient(localConfig)
+	}
+	clientDescLocal := dsl.ClientDesc{
+		Title:        "Lantiq (Local)",
+		RequiresUser: dsl.TristateNo,
+		IsLocal:      true,
+		Options:      options,
+	}
+	dsl.RegisterClient("lantiq_local", newLocal, clientDescLocal)
+}
+
+func parseLineCount(str string) int {
+	lineCount, err := strconv.Atoi(str)
+	if err != nil || lineCount < 1 {
+		return 1
+	}
+	return lineCount
 }
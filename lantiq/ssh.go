@@ -11,20 +11,23 @@ import (
 )
 
 type sshClient struct {
-	command string
-	client  *ssh.Client
-	rawData []byte
-	status  models.Status
-	bins    models.Bins
+	command   string
+	lineCount int
+	client    *ssh.Client
+	rawData   []byte
+	status    models.Status
+	bins      models.Bins
+	lines     []models.Line
 }
 
 func NewSSHClient(config SSHConfig) (dsl.Client, error) {
 	c := sshClient{}
 	c.command = config.Command
+	c.lineCount = config.LineCount
 
 	var err error
 
-	c.client, err = ssh.NewClient(config.Host, config.User, config.Password, config.PrivateKeys, config.KnownHosts)
+	c.client, err = ssh.NewClient(config.Host, config.User, config.Password, config.PrivateKeys, config.SSHAgent, config.KnownHosts, config.UnknownHostKey, config.JumpHost)
 	if err != nil {
 		return nil, err
 	}
@@ -44,8 +47,15 @@ func (c *sshClient) Bins() models.Bins {
 	return c.bins
 }
 
+func (c *sshClient) Lines() []models.Line {
+	return c.lines
+}
+
 func (c *sshClient) UpdateData() (err error) {
-	c.status, c.bins, c.rawData, err = updateData(c.client, c.command)
+	c.lines, c.rawData, err = updateData(c.client, c.command, c.lineCount)
+	if err == nil {
+		c.status, c.bins = c.lines[0].Status, c.lines[0].Bins
+	}
 	return
 }
 
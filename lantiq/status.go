@@ -39,7 +39,8 @@ func parseBasicStatus(data *data) models.Status {
 
 	parseStatusState(&status, data.LineState)
 	parseStatusMode(&status, data.G997_XTUSystemEnablingStatus, data.BandPlanSTatus)
-	parseStatusInventory(&status, data.VersionInformation, data.G997_LineInventory_Far)
+	parseStatusEnabledModes(&status, data.G997_XTUSystemEnablingConfig)
+	parseStatusInventory(&status, data.VersionInformation, data.G997_LineInventory_Near, data.G997_LineInventory_Far)
 
 	return status
 }
@@ -47,10 +48,16 @@ func parseBasicStatus(data *data) models.Status {
 func parseExtendedStatus(status *models.Status, bins *models.Bins, data *data) {
 	parseStatusChannelStatus(status, data.G997_ChannelStatus_US, data.G997_ChannelStatus_DS, data.APIVersion)
 	parseStatusLineStatus(status, bins, data.G997_LineStatus_US, data.G997_LineStatus_DS)
+	parseStatusLineStatusPerBand(status, bins, data.G997_LineStatusPerBand_US, data.G997_LineStatusPerBand_DS,
+		data.G997_LineStatus_US, data.G997_LineStatus_DS)
 	parseStatusLineFeatures(status, data.LineFeatureStatus_US, data.LineFeatureStatus_DS)
+	parseStatusFramingParameters(status, data.FramingParameterStatus_US, data.FramingParameterStatus_DS)
+	parseStatusTransportMode(status, data.TcStatus)
 	parseStatusRateAdaptationStatus(status, data.G997_RateAdaptationStatus_US, data.G997_RateAdaptationStatus_DS)
 	parseStatusOlrStatistics(status, data.OlrStatistics_US, data.OlrStatistics_DS)
 	parseStatusDSMStatus(status, data.DSM_Status)
+	parseStatusDSMStatistics(status, data.DSM_Statistics)
+	parseStatusPowerBackOff(status, data.G997_UsPowerBackOffStatus)
 
 	normalizeOLRValues(status)
 
@@ -65,6 +72,10 @@ func parseExtendedStatus(status *models.Status, bins *models.Bins, data *data) {
 		parseStatusReTxCounters(status, data.PM_ReTxCountersShowtimeGet_Near, data.PM_ReTxCountersShowtimeGet_Far)
 		parseStatusReTxStatistics(status, data.ReTxStatistics_Near, data.ReTxStatistics_Far)
 	}
+
+	parseStatusLineInitCounters(status, data.PM_LineInitCountersTotal)
+
+	helpers.CompleteRetransmissionStatus(status)
 }
 
 func normalizeOLRValues(status *models.Status) {
@@ -255,6 +266,8 @@ func parseStatusMode(status *models.Status, g997xtusesg, bpstg dataItem) {
 	xtse7 := interpretStatusByte(g977xtusesgValues, "XTSE7", "ATSE7")
 	xtse8 := interpretStatusByte(g977xtusesgValues, "XTSE8", "ATSE8")
 
+	status.Configuration.NegotiatedXTSE = models.XTSE{xtse1, xtse2, xtse3, xtse4, xtse5, xtse6, xtse7, xtse8}
+
 	status.Mode.Type = getStatusModeType(xtse1, xtse2, xtse3, xtse4, xtse5, xtse6, xtse7, xtse8)
 
 	if status.Mode.Type == models.ModeTypeVDSL2 || (status.Mode.Type == models.ModeTypeUnknown && status.Mode.Subtype == models.ModeSubtypeUnknown) {
@@ -289,17 +302,38 @@ func parseStatusMode(status *models.Status, g997xtusesg, bpstg dataItem) {
 	}
 }
 
-func parseStatusInventory(status *models.Status, vig, g997ligFar dataItem) {
+func parseStatusEnabledModes(status *models.Status, g997xtusecg dataItem) {
+	values := parseValues(g997xtusecg.Output)
+
+	var xtse models.XTSE
+	for i := range xtse {
+		n := strconv.Itoa(i + 1)
+		xtse[i] = interpretStatusByte(values, "XTSE"+n, "ATSE"+n)
+	}
+
+	status.Configuration.EnabledXTSE = xtse
+	status.Configuration.EnabledModes = getModes(xtse)
+}
+
+func parseStatusInventory(status *models.Status, vig, g997ligNear, g997ligFar dataItem) {
 	vigValues := parseValues(vig.Output)
 	status.NearEndInventory.Vendor = "Infineon"
 	status.NearEndInventory.Version = vigValues["DSL_ChipSetFWVersion"]
+	status.NearEndInventory.Model = vigValues["DSL_ChipSetType"]
+
+	g997ligNearValues := parseValues(g997ligNear.Output)
+	interpretStatusInventoryDetails(&status.NearEndInventory, g997ligNearValues)
 
 	g997ligFarValues := parseValues(g997ligFar.Output)
-	vendorID := interpretStatusBytes(g997ligFarValues, "G994VendorID")
-	if len(vendorID) == 8 {
-		status.FarEndInventory.Vendor = helpers.FormatVendor(string(vendorID[2:6]))
-		status.FarEndInventory.Version = helpers.FormatVersion(status.FarEndInventory.Vendor, vendorID[6:8])
-	}
+	helpers.InterpretVendorID(&status.FarEndInventory, interpretStatusBytes(g997ligFarValues, "G994VendorID"))
+	interpretStatusInventoryDetails(&status.FarEndInventory, g997ligFarValues)
+}
+
+func interpretStatusInventoryDetails(inventory *models.Inventory, values map[string]string) {
+	helpers.InterpretSystemVendorID(inventory, interpretStatusBytes(values, "SystemVendorID"))
+	inventory.VersionNumber = helpers.FormatInventoryString(interpretStatusBytes(values, "VersionNumber"))
+	inventory.SerialNumber = helpers.FormatInventoryString(interpretStatusBytes(values, "SerialNumber"))
+	inventory.SelfTestResult = interpretStatusIntValue(values, "SelfTestResult", 1)
 }
 
 func parseStatusChannelStatus(status *models.Status, g997csgUS, g997csgDS dataItem, apiVersion string) {
@@ -326,6 +360,20 @@ func parseStatusChannelStatus(status *models.Status, g997csgUS, g997csgDS dataIt
 		status.UpstreamImpulseNoiseProtection.FloatValue.Float *= 5
 		status.DownstreamImpulseNoiseProtection.FloatValue.Float *= 5
 	}
+
+	status.UpstreamRetransmission.NetDataRate.IntValue = interpretStatusIntValue(g997csgUSValues, "ActualNetDataRate", 1000)
+	status.DownstreamRetransmission.NetDataRate.IntValue = interpretStatusIntValue(g997csgDSValues, "ActualNetDataRate", 1000)
+
+	// the expected throughput is only reported by newer API versions
+	status.UpstreamRetransmission.ExpectedThroughput.IntValue =
+		interpretStatusIntValue(g997csgUSValues, "ActualExpectedThroughput", 1000)
+	status.DownstreamRetransmission.ExpectedThroughput.IntValue =
+		interpretStatusIntValue(g997csgDSValues, "ActualExpectedThroughput", 1000)
+
+	status.UpstreamRetransmission.ImpulseNoiseProtectionREIN.FloatValue =
+		interpretStatusFloatValue(g997csgUSValues, "ActualImpulseNoiseProtectionRein", 10)
+	status.DownstreamRetransmission.ImpulseNoiseProtectionREIN.FloatValue =
+		interpretStatusFloatValue(g997csgDSValues, "ActualImpulseNoiseProtectionRein", 10)
 }
 
 func getBandWeights(bands []models.Band) []float64 {
@@ -386,6 +434,62 @@ func parseStatusLineStatus(status *models.Status, bins *models.Bins, g997lsgUS,
 	}
 }
 
+func parseStatusLineStatusPerBand(status *models.Status, bins *models.Bins, g997lspbgUS, g997lspbgDS, g997lsgUS, g997lsgDS dataItem) {
+	if status.Mode.Type != models.ModeTypeVDSL2 {
+		return
+	}
+
+	// older versions only report the per-band values as part of the line status
+	valuesUS := parseValues(g997lspbgUS.Output)
+	if valuesUS == nil {
+		valuesUS = parseValues(g997lsgUS.Output)
+	}
+	valuesDS := parseValues(g997lspbgDS.Output)
+	if valuesDS == nil {
+		valuesDS = parseValues(g997lsgDS.Output)
+	}
+
+	status.UpstreamBands = interpretStatusBands(valuesUS, bins.Bands, true)
+	status.DownstreamBands = interpretStatusBands(valuesDS, bins.Bands, false)
+}
+
+func interpretStatusBands(values map[string]string, bands models.BandsDownUp, upstream bool) (out []models.BandStatus) {
+	count := len(bands.Downstream)
+	if upstream {
+		count = len(bands.Upstream)
+	}
+	if count == 0 || count > 5 {
+		count = 5
+	}
+
+	for i := 0; i < count; i++ {
+		index := "[" + strconv.Itoa(i) + "]"
+
+		band := models.BandStatus{Name: helpers.BandName(bands, upstream, i)}
+		band.Attenuation.FloatValue = interpretStatusBandValue(values, "LATN"+index, 0, 1270)
+		band.SignalAttenuation.FloatValue = interpretStatusBandValue(values, "SATN"+index, 0, 1270)
+		band.SNRMargin.FloatValue = interpretStatusBandValue(values, "SNR"+index, -511, 511)
+
+		if band.IsValid() {
+			out = append(out, band)
+		}
+	}
+
+	return
+}
+
+// interpretStatusBandValue reads a value in 0.1 dB, ignoring special values (such as 1271 for LATN, or
+// -512 for SNR margin) which indicate that the value is not available.
+func interpretStatusBandValue(values map[string]string, key string, min, max int64) (out models.FloatValue) {
+	if val, ok := values[key]; ok {
+		if valInt, err := strconv.ParseInt(val, 10, 64); err == nil && valInt >= min && valInt <= max {
+			out.Float = float64(valInt) / 10
+			out.Valid = true
+		}
+	}
+	return
+}
+
 func parseStatusLineFeatures(status *models.Status, lfsgUS, lfsgDS dataItem) {
 	lfsgUSValues := parseValues(lfsgUS.Output)
 	lfsgDSValues := parseValues(lfsgDS.Output)
@@ -395,6 +499,55 @@ func parseStatusLineFeatures(status *models.Status, lfsgUS, lfsgDS dataItem) {
 
 	status.UpstreamBitswap.Enabled = interpretStatusBoolValue(lfsgUSValues, "bBitswapEnable")
 	status.DownstreamBitswap.Enabled = interpretStatusBoolValue(lfsgDSValues, "bBitswapEnable")
+
+	status.Configuration.UpstreamTrellis = interpretStatusBoolValue(lfsgUSValues, "bTrellisEnable")
+	status.Configuration.DownstreamTrellis = interpretStatusBoolValue(lfsgDSValues, "bTrellisEnable")
+
+	status.Configuration.UpstreamSNRMode = interpretStatusSNRMode(lfsgUSValues, "bVirtualNoiseSupport")
+	status.Configuration.DownstreamSNRMode = interpretStatusSNRMode(lfsgDSValues, "bVirtualNoiseSupport")
+}
+
+func interpretStatusSNRMode(values map[string]string, key string) (out models.IntValue) {
+	if virtualNoise := interpretStatusBoolValue(values, key); virtualNoise.Valid {
+		out.Int = 1
+		if virtualNoise.Bool {
+			out.Int = 2
+		}
+		out.Valid = true
+	}
+	return
+}
+
+func parseStatusFramingParameters(status *models.Status, fpsgUS, fpsgDS dataItem) {
+	status.Configuration.UpstreamFraming = interpretStatusFraming(parseValues(fpsgUS.Output))
+	status.Configuration.DownstreamFraming = interpretStatusFraming(parseValues(fpsgDS.Output))
+}
+
+func interpretStatusFraming(values map[string]string) (out models.Framing) {
+	out.L = interpretStatusIntValue(values, "nLSYMB", 1)
+	out.D = interpretStatusIntValue(values, "nINTLVDEPTH", 1)
+	out.N = interpretStatusIntValue(values, "nNFEC", 1)
+	out.R = interpretStatusIntValue(values, "nRFEC", 1)
+	out.I = interpretStatusIntValue(values, "nINTLVBLOCK", 1)
+	out.M = interpretStatusIntValue(values, "nMp", 1)
+	out.T = interpretStatusIntValue(values, "nTp", 1)
+	return
+}
+
+func parseStatusTransportMode(status *models.Status, tsg dataItem) {
+	tsgValues := parseValues(tsg.Output)
+
+	tcLayer := interpretStatusIntValue(tsgValues, "nTcLayer", 1)
+	if !tcLayer.Valid {
+		return
+	}
+
+	switch tcLayer.Int {
+	case tcLayerATM:
+		status.Configuration.TransportMode = models.TransportModeATM
+	case tcLayerEFM, tcLayerEFMForced:
+		status.Configuration.TransportMode = models.TransportModePTM
+	}
 }
 
 func parseStatusRateAdaptationStatus(status *models.Status, g997rasgUS, g997rasgDS dataItem) {
@@ -422,6 +575,30 @@ func parseStatusDSMStatus(status *models.Status, dsmsg dataItem) {
 	status.DownstreamVectoringState, status.UpstreamVectoringState = interpretVectoringState(dsmsgValues)
 }
 
+func parseStatusDSMStatistics(status *models.Status, dsmstatg dataItem) {
+	dsmstatgValues := parseValues(dsmstatg.Output)
+
+	status.Vectoring.ErrorSampleCount = interpretStatusIntValue(dsmstatgValues, "n_processed", 1)
+
+	// there are separate counters for the different reasons of dropped error samples
+	for key := range dsmstatgValues {
+		if !strings.HasPrefix(key, "n_") || !strings.Contains(key, "dropped") {
+			continue
+		}
+
+		if val := interpretStatusIntValue(dsmstatgValues, key, 1); val.Valid {
+			status.Vectoring.DiscardedErrorSampleCount.Int += val.Int
+			status.Vectoring.DiscardedErrorSampleCount.Valid = true
+		}
+	}
+}
+
+func parseStatusPowerBackOff(status *models.Status, g997upbosg dataItem) {
+	g997upbosgValues := parseValues(g997upbosg.Output)
+
+	status.PowerBackOff.UPBOElectricalLength.FloatValue = interpretStatusBandValue(g997upbosgValues, "nKl0", 0, 1280)
+}
+
 func parseStatusChannelCounters(status *models.Status, pmccsgNear, pmccsgFar dataItem) {
 	pmccsgNearValues := parseValues(pmccsgNear.Output)
 	pmccsgFarValues := parseValues(pmccsgFar.Output)
@@ -447,6 +624,22 @@ func parseStatusLineSecCounters(status *models.Status, pmlscsgNear, pmlscsgFar d
 
 	status.UpstreamSESCount = interpretStatusIntValue(pmlscsgFarValues, "nSES", 1)
 	status.DownstreamSESCount = interpretStatusIntValue(pmlscsgNearValues, "nSES", 1)
+
+	status.UpstreamLOSSCount = interpretStatusIntValue(pmlscsgFarValues, "nLOSS", 1)
+	status.DownstreamLOSSCount = interpretStatusIntValue(pmlscsgNearValues, "nLOSS", 1)
+
+	status.UpstreamLOFSCount = interpretStatusIntValue(pmlscsgFarValues, "nLOFS", 1)
+	status.DownstreamLOFSCount = interpretStatusIntValue(pmlscsgNearValues, "nLOFS", 1)
+
+	status.UpstreamUASCount = interpretStatusIntValue(pmlscsgFarValues, "nUAS", 1)
+	status.DownstreamUASCount = interpretStatusIntValue(pmlscsgNearValues, "nUAS", 1)
+}
+
+func parseStatusLineInitCounters(status *models.Status, pmlictg dataItem) {
+	pmlictgValues := parseValues(pmlictg.Output)
+
+	status.FullInitCount = interpretStatusIntValue(pmlictgValues, "nFullInits", 1)
+	status.FailedFullInitCount = interpretStatusIntValue(pmlictgValues, "nFailedFullInits", 1)
 }
 
 func parseStatusReTxCounters(status *models.Status, pmrtcsgNear, pmrtcsgFar dataItem) {
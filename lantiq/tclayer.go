@@ -0,0 +1,13 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package lantiq
+
+const (
+	tcLayerATM       = 1
+	tcLayerEFM       = 2
+	tcLayerHDLC      = 3
+	tcLayerAuto      = 4
+	tcLayerEFMForced = 5
+)
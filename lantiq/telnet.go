@@ -11,16 +11,19 @@ import (
 )
 
 type telnetClient struct {
-	command string
-	client  *telnet.Client
-	rawData []byte
-	status  models.Status
-	bins    models.Bins
+	command   string
+	lineCount int
+	client    *telnet.Client
+	rawData   []byte
+	status    models.Status
+	bins      models.Bins
+	lines     []models.Line
 }
 
 func NewTelnetClient(config TelnetConfig) (dsl.Client, error) {
 	c := telnetClient{}
 	c.command = config.Command
+	c.lineCount = config.LineCount
 
 	var err error
 
@@ -37,7 +40,13 @@ func NewTelnetClient(config TelnetConfig) (dsl.Client, error) {
 				Command:  "# ",
 			},
 		},
+		Serial:      config.Serial,
+		BaudRate:    config.BaudRate,
+		PreCommands: config.PreCommands,
 	}
+	clientConfig.Prompts = telnet.WithCustomPrompts(clientConfig.Prompts,
+		config.AccountPrompt, config.PasswordPrompt, config.CommandPrompt)
+
 	c.client, err = telnet.NewClient(clientConfig, config.Host, user, config.Password)
 	if err != nil {
 		return nil, err
@@ -58,8 +67,15 @@ func (c *telnetClient) Bins() models.Bins {
 	return c.bins
 }
 
+func (c *telnetClient) Lines() []models.Line {
+	return c.lines
+}
+
 func (c *telnetClient) UpdateData() (err error) {
-	c.status, c.bins, c.rawData, err = updateData(c.client, c.command)
+	c.lines, c.rawData, err = updateData(c.client, c.command, c.lineCount)
+	if err == nil {
+		c.status, c.bins = c.lines[0].Status, c.lines[0].Bins
+	}
 	return
 }
 
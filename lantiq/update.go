@@ -5,22 +5,40 @@
 package lantiq
 
 import (
+	"fmt"
+
 	"3e8.eu/go/dsl/internal/exec"
 	"3e8.eu/go/dsl/models"
 )
 
-func updateData(e exec.Executor, command string) (status models.Status, bins models.Bins, rawData []byte, err error) {
-	var data data
-
-	err = data.LoadData(e, command)
-	if err != nil {
-		return
+func updateData(e exec.Executor, command string, lineCount int) (lines []models.Line, rawData []byte, err error) {
+	if lineCount < 1 {
+		lineCount = 1
 	}
 
-	status = parseBasicStatus(&data)
-	bins = parseBins(&status, &data)
-	parseExtendedStatus(&status, &bins, &data)
-	rawData = data.RawData()
+	for line := 0; line < lineCount; line++ {
+		var data data
+		if lineCount > 1 {
+			data.MultiLine = true
+			data.Line = line
+		}
+
+		err = data.LoadData(e, command)
+		if err != nil {
+			return
+		}
+
+		status := parseBasicStatus(&data)
+		bins := parseBins(&status, &data)
+		parseExtendedStatus(&status, &bins, &data)
+
+		lines = append(lines, models.Line{Status: status, Bins: bins})
+
+		if lineCount > 1 {
+			rawData = append(rawData, []byte(fmt.Sprintf("# line %d\n\n", line+1))...)
+		}
+		rawData = append(rawData, data.RawData()...)
+	}
 
 	return
 }
@@ -183,3 +183,60 @@ func getStatusModeSubtype(xtse1, xtse2, xtse3, xtse4, xtse5, xtse6, xtse7, xtse8
 
 	return models.ModeSubtypeUnknown
 }
+
+var xtseModes = []struct {
+	octet int
+	bit   byte
+	mode  models.Mode
+}{
+	{1, xtse_1_03_A_1_NO, models.Mode{Type: models.ModeTypeADSL, Subtype: models.ModeSubtypeAnnexA}},
+	{1, xtse_1_04_A_1_O, models.Mode{Type: models.ModeTypeADSL, Subtype: models.ModeSubtypeAnnexA}},
+	{1, xtse_1_05_B_1_NO, models.Mode{Type: models.ModeTypeADSL, Subtype: models.ModeSubtypeAnnexB}},
+	{1, xtse_1_06_B_1_O, models.Mode{Type: models.ModeTypeADSL, Subtype: models.ModeSubtypeAnnexB}},
+
+	{3, xtse_3_03_A_3_NO, models.Mode{Type: models.ModeTypeADSL2, Subtype: models.ModeSubtypeAnnexA}},
+	{3, xtse_3_04_A_3_O, models.Mode{Type: models.ModeTypeADSL2, Subtype: models.ModeSubtypeAnnexA}},
+	{3, xtse_3_05_B_3_NO, models.Mode{Type: models.ModeTypeADSL2, Subtype: models.ModeSubtypeAnnexB}},
+	{3, xtse_3_06_B_3_O, models.Mode{Type: models.ModeTypeADSL2, Subtype: models.ModeSubtypeAnnexB}},
+	{4, xtse_4_05_I_3_NO, models.Mode{Type: models.ModeTypeADSL2, Subtype: models.ModeSubtypeAnnexI}},
+	{4, xtse_4_06_I_3_O, models.Mode{Type: models.ModeTypeADSL2, Subtype: models.ModeSubtypeAnnexI}},
+	{4, xtse_4_07_J_3_NO, models.Mode{Type: models.ModeTypeADSL2, Subtype: models.ModeSubtypeAnnexJ}},
+	{4, xtse_4_08_J_3_O, models.Mode{Type: models.ModeTypeADSL2, Subtype: models.ModeSubtypeAnnexJ}},
+	{5, xtse_5_03_L_3_NO, models.Mode{Type: models.ModeTypeADSL2, Subtype: models.ModeSubtypeAnnexL}},
+	{5, xtse_5_04_L_3_NO, models.Mode{Type: models.ModeTypeADSL2, Subtype: models.ModeSubtypeAnnexL}},
+	{5, xtse_5_05_L_3_O, models.Mode{Type: models.ModeTypeADSL2, Subtype: models.ModeSubtypeAnnexL}},
+	{5, xtse_5_06_L_3_O, models.Mode{Type: models.ModeTypeADSL2, Subtype: models.ModeSubtypeAnnexL}},
+	{5, xtse_5_07_M_3_NO, models.Mode{Type: models.ModeTypeADSL2, Subtype: models.ModeSubtypeAnnexM}},
+	{5, xtse_5_08_M_3_O, models.Mode{Type: models.ModeTypeADSL2, Subtype: models.ModeSubtypeAnnexM}},
+
+	{6, xtse_6_01_A_5_NO, models.Mode{Type: models.ModeTypeADSL2Plus, Subtype: models.ModeSubtypeAnnexA}},
+	{6, xtse_6_02_A_5_O, models.Mode{Type: models.ModeTypeADSL2Plus, Subtype: models.ModeSubtypeAnnexA}},
+	{6, xtse_6_03_B_5_NO, models.Mode{Type: models.ModeTypeADSL2Plus, Subtype: models.ModeSubtypeAnnexB}},
+	{6, xtse_6_04_B_5_O, models.Mode{Type: models.ModeTypeADSL2Plus, Subtype: models.ModeSubtypeAnnexB}},
+	{6, xtse_6_07_I_5_NO, models.Mode{Type: models.ModeTypeADSL2Plus, Subtype: models.ModeSubtypeAnnexI}},
+	{6, xtse_6_08_I_5_O, models.Mode{Type: models.ModeTypeADSL2Plus, Subtype: models.ModeSubtypeAnnexI}},
+	{7, xtse_7_01_J_5_NO, models.Mode{Type: models.ModeTypeADSL2Plus, Subtype: models.ModeSubtypeAnnexJ}},
+	{7, xtse_7_02_J_5_O, models.Mode{Type: models.ModeTypeADSL2Plus, Subtype: models.ModeSubtypeAnnexJ}},
+	{7, xtse_7_03_M_5_NO, models.Mode{Type: models.ModeTypeADSL2Plus, Subtype: models.ModeSubtypeAnnexM}},
+	{7, xtse_7_04_M_5_O, models.Mode{Type: models.ModeTypeADSL2Plus, Subtype: models.ModeSubtypeAnnexM}},
+
+	{8, xtse_8_01_A, models.Mode{Type: models.ModeTypeVDSL2, Subtype: models.ModeSubtypeAnnexA}},
+	{8, xtse_8_02_B, models.Mode{Type: models.ModeTypeVDSL2, Subtype: models.ModeSubtypeAnnexB}},
+	// there is no subtype for Annex C (Japan)
+	{8, xtse_8_03_C, models.Mode{Type: models.ModeTypeVDSL2}},
+}
+
+// getModes returns all modes which are enabled in the XTSE bit field, without distinguishing
+// between overlapped and non-overlapped spectrum
+func getModes(xtse models.XTSE) (modes []models.Mode) {
+	for _, item := range xtseModes {
+		if xtse[item.octet-1]&item.bit == 0 {
+			continue
+		}
+		if len(modes) != 0 && modes[len(modes)-1] == item.mode {
+			continue
+		}
+		modes = append(modes, item.mode)
+	}
+	return
+}
@@ -9,15 +9,26 @@ import (
 )
 
 type TelnetConfig struct {
-	Host     string
-	User     string
-	Password dsl.PasswordCallback
+	Host           string
+	User           string
+	Password       dsl.PasswordCallback
+	Serial         bool
+	BaudRate       int
+	AccountPrompt  string
+	PasswordPrompt string
+	CommandPrompt  string
+	PreCommands    []string
 }
 
 type SSHConfig struct {
-	Host        string
-	User        string
-	Password    dsl.PasswordCallback
-	PrivateKeys dsl.PrivateKeysCallback
-	KnownHosts  string
+	Host           string
+	User           string
+	Password       dsl.PasswordCallback
+	PrivateKeys    dsl.PrivateKeysCallback
+	SSHAgent       bool
+	KnownHosts     string
+	UnknownHostKey dsl.UnknownHostKeyCallback
+	JumpHost       *dsl.JumpHostConfig
 }
+
+type LocalConfig struct{}
@@ -0,0 +1,43 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package mediatek
+
+import (
+	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/exec"
+	"3e8.eu/go/dsl/models"
+)
+
+type localClient struct {
+	executor exec.LocalExecutor
+	rawData  []byte
+	status   models.Status
+	bins     models.Bins
+}
+
+func NewLocalClient(config LocalConfig) (dsl.Client, error) {
+	c := localClient{}
+	return &c, nil
+}
+
+func (c *localClient) RawData() []byte {
+	return c.rawData
+}
+
+func (c *localClient) Status() models.Status {
+	return c.status
+}
+
+func (c *localClient) Bins() models.Bins {
+	return c.bins
+}
+
+func (c *localClient) UpdateData() (err error) {
+	c.status, c.bins, c.rawData, err = updateData(&c.executor)
+	return
+}
+
+func (c *localClient) Close() {
+}
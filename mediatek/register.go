@@ -6,14 +6,21 @@ package mediatek
 
 import (
 	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/telnet"
 )
 
 func init() {
 	newTelnet := func(config dsl.Config) (dsl.Client, error) {
 		telnetConfig := TelnetConfig{
-			Host:     config.Host,
-			User:     config.User,
-			Password: config.AuthPassword,
+			Host:           config.Host,
+			User:           config.User,
+			Password:       config.AuthPassword,
+			Serial:         config.Options["Serial"] == "1",
+			BaudRate:       telnet.ParseBaudRate(config.Options["BaudRate"]),
+			AccountPrompt:  config.Options["AccountPrompt"],
+			PasswordPrompt: config.Options["PasswordPrompt"],
+			CommandPrompt:  config.Options["CommandPrompt"],
+			PreCommands:    telnet.ParsePreCommands(config.Options["PreCommands"]),
 		}
 		return NewTelnetClient(telnetConfig)
 	}
@@ -21,24 +28,47 @@ func init() {
 		Title:              "MediaTek (Telnet)",
 		RequiresUser:       dsl.TristateMaybe,
 		SupportedAuthTypes: dsl.AuthTypePassword,
+		Options: map[string]dsl.Option{
+			"Serial":         telnet.GetSerialOption(),
+			"BaudRate":       telnet.GetBaudRateOption(),
+			"AccountPrompt":  telnet.GetAccountPromptOption(),
+			"PasswordPrompt": telnet.GetPasswordPromptOption(),
+			"CommandPrompt":  telnet.GetCommandPromptOption(),
+			"PreCommands":    telnet.GetPreCommandsOption(),
+		},
 	}
 	dsl.RegisterClient("mediatek_telnet", newTelnet, clientDescTelnet)
 
 	newSSH := func(config dsl.Config) (dsl.Client, error) {
 		sshConfig := SSHConfig{
-			Host:        config.Host,
-			User:        config.User,
-			Password:    config.AuthPassword,
-			PrivateKeys: config.AuthPrivateKeys,
-			KnownHosts:  config.KnownHosts,
+			Host:           config.Host,
+			User:           config.User,
+			Password:       config.AuthPassword,
+			PrivateKeys:    config.AuthPrivateKeys,
+			SSHAgent:       config.AuthSSHAgent,
+			KnownHosts:     config.KnownHosts,
+			UnknownHostKey: config.UnknownHostKey,
+			JumpHost:       config.JumpHost,
 		}
 		return NewSSHClient(sshConfig)
 	}
 	clientDescSSH := dsl.ClientDesc{
 		Title:              "MediaTek (SSH)",
 		RequiresUser:       dsl.TristateYes,
-		SupportedAuthTypes: dsl.AuthTypePassword | dsl.AuthTypePrivateKeys,
+		SupportedAuthTypes: dsl.AuthTypePassword | dsl.AuthTypePrivateKeys | dsl.AuthTypeSSHAgent,
 		RequiresKnownHosts: true,
+		SupportsJumpHost:   true,
 	}
 	dsl.RegisterClient("mediatek_ssh", newSSH, clientDescSSH)
+
+	newLocal := func(config dsl.Config) (dsl.Client, error) {
+		localConfig := LocalConfig{}
+		return NewLocalClient(localConfig)
+	}
+	clientDescLocal := dsl.ClientDesc{
+		Title:        "MediaTek (Local)",
+		RequiresUser: dsl.TristateNo,
+		IsLocal:      true,
+	}
+	dsl.RegisterClient("mediatek_local", newLocal, clientDescLocal)
 }
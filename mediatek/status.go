@@ -47,6 +47,7 @@ func parseStatus(adslStats, vdslInterfaceConfig, adslFwVer,
 	parseExtendedStatusCounters(&status, valuesMgcnt)
 	parseExtendedStatusRetransmission(&status, valuesMgcnt)
 	parseExtendedStatusINPDelay(&status, valuesMgcnt, valuesPmsPmdRx, valuesPmsPmdTx)
+	parseExtendedStatusFraming(&status, valuesPmsPmdRx, valuesPmsPmdTx)
 
 	return status
 }
@@ -400,3 +401,19 @@ func parseExtendedStatusINPDelay(status *models.Status, valuesMgcnt, valuesPmsPm
 		status.UpstreamInterleavingDelay.FloatValue = val
 	}
 }
+
+func parseExtendedStatusFraming(status *models.Status, valuesPmsPmdRx, valuesPmsPmdTx map[string]string) {
+	status.Configuration.DownstreamFraming = interpretFraming(valuesPmsPmdRx)
+	status.Configuration.UpstreamFraming = interpretFraming(valuesPmsPmdTx)
+}
+
+func interpretFraming(values map[string]string) (out models.Framing) {
+	out.L = interpretIntValue(values, "", "l")
+	out.D = interpretIntValue(values, "", "d")
+	out.N = interpretIntValue(values, "", "n")
+	out.R = interpretIntValue(values, "", "r")
+	out.I = interpretIntValue(values, "", "i")
+	out.M = interpretIntValue(values, "", "m")
+	out.T = interpretIntValue(values, "", "t")
+	return
+}
@@ -4,6 +4,10 @@
 
 package models
 
+import (
+	"math"
+)
+
 type Bins struct {
 	Mode Mode
 
@@ -23,6 +27,19 @@ type Bins struct {
 
 	// Hlog is the channel characteristic and estimates the attenuation in dB, valid range: -96.2 to 6
 	Hlog BinsFloatDownUp
+
+	// Hlin is the complex channel characteristic, which is only reported by some devices
+	Hlin BinsComplexDownUp
+
+	// Gains is the fine gain of the subcarrier in dB, valid range: -14.5 to 2.5
+	Gains BinsFloatDownUp
+
+	// PSD is the transmit power spectral density mask in dBm/Hz, valid range: -150 to -20
+	PSD BinsFloatDownUp
+
+	// FEXTCancellation is the gain of the crosstalk (FEXT) cancellation by vectoring in dB, which is the
+	// difference between the crosstalk with and without cancellation, valid range: 0 (exclusive) to 100
+	FEXTCancellation BinsFloatDownUp
 }
 
 type BandsDownUp struct {
@@ -56,3 +73,39 @@ type BinsFloat struct {
 	// Data contains the actual data, with multiple bins grouped
 	Data []float64
 }
+
+type BinsComplexDownUp struct {
+	Downstream BinsComplex
+	Upstream   BinsComplex
+}
+
+type BinsComplex struct {
+	// GroupSize specifies how many bins are grouped into a single value
+	GroupSize int
+
+	// Real and Imag contain the real and imaginary parts of the actual data, with multiple bins grouped
+	Real []float64
+	Imag []float64
+}
+
+// Decibel returns the magnitude of the data in dB, in the same format as used for Hlog.
+func (b BinsComplex) Decibel() BinsFloat {
+	out := BinsFloat{GroupSize: b.GroupSize}
+
+	count := len(b.Real)
+	if len(b.Imag) < count {
+		count = len(b.Imag)
+	}
+
+	out.Data = make([]float64, count)
+	for i := 0; i < count; i++ {
+		magnitude := math.Hypot(b.Real[i], b.Imag[i])
+		if magnitude == 0 {
+			out.Data[i] = -96.3
+			continue
+		}
+		out.Data[i] = math.Max(-96.2, math.Min(6, 20*math.Log10(magnitude)))
+	}
+
+	return out
+}
@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+type TransportMode int
+
+const (
+	TransportModeUnknown TransportMode = iota
+	TransportModeATM
+	TransportModePTM
+)
+
+func (t TransportMode) String() string {
+	switch t {
+	case TransportModeATM:
+		return "ATM"
+	case TransportModePTM:
+		return "PTM"
+	}
+	return "Unknown"
+}
+
+// XTSE contains the octets of the xDSL transmission system enabling (XTSE) bit field according to G.997.1
+type XTSE [8]byte
+
+// IsValid returns true if at least one bit is set.
+func (x XTSE) IsValid() bool {
+	return x != XTSE{}
+}
+
+func (x XTSE) String() string {
+	if !x.IsValid() {
+		return ""
+	}
+	items := make([]string, len(x))
+	for i, octet := range x {
+		items[i] = fmt.Sprintf("%02x", octet)
+	}
+	return strings.Join(items, " ")
+}
+
+// Framing contains the framing parameters of a bearer channel according to G.992.3 and G.993.2
+type Framing struct {
+	L IntValue // bits per symbol
+	D IntValue // interleaving depth
+	N IntValue // RS codeword size
+	R IntValue // RS redundancy bytes
+	I IntValue // interleaver block length
+	M IntValue // MDFs per RS codeword
+	T IntValue // MDFs per overhead octet
+}
+
+// IsValid returns true if at least one value is available.
+func (f Framing) IsValid() bool {
+	return f.L.Valid || f.D.Valid || f.N.Valid || f.R.Valid || f.I.Valid || f.M.Valid || f.T.Valid
+}
+
+// LineConfiguration contains the configuration of the line, as enabled on the modem and as negotiated with the
+// far end
+type LineConfiguration struct {
+	TransportMode TransportMode
+
+	EnabledXTSE    XTSE
+	NegotiatedXTSE XTSE
+
+	// EnabledModes and EnabledProfiles list the modes and VDSL2 profiles which are enabled on the modem, the
+	// negotiated ones are reported as Mode of the status
+	EnabledModes    []Mode
+	EnabledProfiles []ModeSubtype
+
+	DownstreamTrellis BoolValue
+	UpstreamTrellis   BoolValue
+
+	// DownstreamSNRMode and UpstreamSNRMode are the SNRMODE according to G.997.1 (1: virtual noise disabled,
+	// 2: virtual noise enabled)
+	DownstreamSNRMode IntValue
+	UpstreamSNRMode   IntValue
+
+	DownstreamFraming Framing
+	UpstreamFraming   Framing
+}
+
+// IsValid returns true if at least one value is available.
+func (c LineConfiguration) IsValid() bool {
+	return c.TransportMode != TransportModeUnknown ||
+		c.EnabledXTSE.IsValid() || c.NegotiatedXTSE.IsValid() ||
+		len(c.EnabledModes) != 0 || len(c.EnabledProfiles) != 0 ||
+		c.DownstreamTrellis.Valid || c.UpstreamTrellis.Valid ||
+		c.DownstreamSNRMode.Valid || c.UpstreamSNRMode.Valid ||
+		c.DownstreamFraming.IsValid() || c.UpstreamFraming.IsValid()
+}
+
+// EnabledModesString returns the enabled modes as comma-separated list.
+func (c LineConfiguration) EnabledModesString() string {
+	items := make([]string, len(c.EnabledModes))
+	for i, mode := range c.EnabledModes {
+		items[i] = mode.String()
+	}
+	return strings.Join(items, ", ")
+}
+
+// EnabledProfilesString returns the enabled VDSL2 profiles as comma-separated list.
+func (c LineConfiguration) EnabledProfilesString() string {
+	items := make([]string, len(c.EnabledProfiles))
+	for i, profile := range c.EnabledProfiles {
+		items[i] = strings.TrimPrefix(profile.String(), "Profile ")
+	}
+	return strings.Join(items, ", ")
+}
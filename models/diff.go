@@ -0,0 +1,424 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package models
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// StatusChange describes a single value which differs between two status values.
+type StatusChange struct {
+	// Field is the path of the value within Status, such as DownstreamSNRMargin or DownstreamBands.D1.Power
+	Field string
+
+	Old Value
+	New Value
+}
+
+func (c StatusChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Field, c.Old, c.New)
+}
+
+// CounterDelta describes the increase of an error counter between two status values.
+type CounterDelta struct {
+	// Field is the name of the counter within Status, such as DownstreamCRCCount
+	Field string
+
+	// Delta is the increase of the counter, it is invalid if it cannot be determined
+	Delta IntValue
+
+	// Wrapped is set if the counter overflowed at 32 bits
+	Wrapped bool
+
+	// Reset is set if the counter was reset, for example due to a resync
+	Reset bool
+}
+
+func (d CounterDelta) String() string {
+	switch {
+	case d.Reset:
+		return d.Field + ": reset"
+	case d.Wrapped:
+		return d.Field + ": +" + d.Delta.String() + " (wrapped)"
+	}
+	return d.Field + ": +" + d.Delta.String()
+}
+
+// CalculateCounterDelta returns the increase of an error counter from lastVal to val. The Field of the result is
+// not set.
+func CalculateCounterDelta(lastVal, val IntValue) (out CounterDelta) {
+	if !lastVal.Valid || !val.Valid {
+		return
+	}
+
+	diff := val.Int - lastVal.Int
+	wrapped := false
+
+	if lastVal.Int > val.Int {
+		// The counters are very likely implemented as 32 bit unsigned integers on the device (as that is
+		// the type used in the DSL standards). If an overflow seems plausible, calculate the difference in
+		// 32 bit unsigned arithmetic.
+		if lastVal.Int >= 1<<31 && lastVal.Int < 1<<32 && val.Int >= 0 && val.Int < 1<<31 {
+			diff = int64(uint32(val.Int) - uint32(lastVal.Int))
+			wrapped = true
+		} else {
+			out.Reset = true
+			return
+		}
+	}
+
+	// Reject unreasonably large differences. This is mainly to work around an issue in Lantiq devices.
+	// Shortly after a resync (but not necessarily within the first minute), the downstream FEC counter
+	// may increase by a value close to 2^32.
+	if diff >= 1<<31 {
+		return
+	}
+
+	out.Wrapped = wrapped
+	out.Delta.Valid = true
+	out.Delta.Int = diff
+
+	return
+}
+
+type textValue string
+
+func (v textValue) String() string {
+	return v.Value()
+}
+
+func (v textValue) Value() string {
+	if v != "" {
+		return string(v)
+	}
+	return "-"
+}
+
+func (v textValue) Unit() string {
+	return ""
+}
+
+type statusDiff struct {
+	changes []StatusChange
+}
+
+func (d *statusDiff) compare(field string, lastVal, val Value) {
+	if lastVal.String() != val.String() {
+		d.changes = append(d.changes, StatusChange{Field: field, Old: lastVal, New: val})
+	}
+}
+
+func (d *statusDiff) compareText(field string, lastVal, val string) {
+	d.compare(field, textValue(lastVal), textValue(val))
+}
+
+// DiffStatus compares two status values field by field and returns the values which have changed. Values are
+// compared as displayed (including their unit), so a value becoming valid or invalid is reported as a change,
+// while changes below the displayed precision are ignored. The uptime and the error counters change during
+// normal operation and are therefore not included, use DiffCounters for the latter.
+func DiffStatus(last, status Status) []StatusChange {
+	var d statusDiff
+
+	d.compareText("State", last.State.String(), status.State.String())
+	d.compareText("Mode", last.Mode.String(), status.Mode.String())
+
+	d.compare("DownstreamActualRate", last.DownstreamActualRate, status.DownstreamActualRate)
+	d.compare("UpstreamActualRate", last.UpstreamActualRate, status.UpstreamActualRate)
+
+	d.compare("DownstreamAttainableRate", last.DownstreamAttainableRate, status.DownstreamAttainableRate)
+	d.compare("UpstreamAttainableRate", last.UpstreamAttainableRate, status.UpstreamAttainableRate)
+
+	d.compare("DownstreamMinimumErrorFreeThroughput",
+		last.DownstreamMinimumErrorFreeThroughput, status.DownstreamMinimumErrorFreeThroughput)
+	d.compare("UpstreamMinimumErrorFreeThroughput",
+		last.UpstreamMinimumErrorFreeThroughput, status.UpstreamMinimumErrorFreeThroughput)
+
+	d.compare("DownstreamBitswap.Enabled", last.DownstreamBitswap.Enabled, status.DownstreamBitswap.Enabled)
+	d.compare("UpstreamBitswap.Enabled", last.UpstreamBitswap.Enabled, status.UpstreamBitswap.Enabled)
+
+	d.compare("DownstreamSeamlessRateAdaptation.Enabled",
+		last.DownstreamSeamlessRateAdaptation.Enabled, status.DownstreamSeamlessRateAdaptation.Enabled)
+	d.compare("UpstreamSeamlessRateAdaptation.Enabled",
+		last.UpstreamSeamlessRateAdaptation.Enabled, status.UpstreamSeamlessRateAdaptation.Enabled)
+
+	d.compare("DownstreamInterleavingDelay", last.DownstreamInterleavingDelay, status.DownstreamInterleavingDelay)
+	d.compare("UpstreamInterleavingDelay", last.UpstreamInterleavingDelay, status.UpstreamInterleavingDelay)
+
+	d.compare("DownstreamImpulseNoiseProtection",
+		last.DownstreamImpulseNoiseProtection, status.DownstreamImpulseNoiseProtection)
+	d.compare("UpstreamImpulseNoiseProtection",
+		last.UpstreamImpulseNoiseProtection, status.UpstreamImpulseNoiseProtection)
+
+	d.compare("DownstreamRetransmissionEnabled",
+		last.DownstreamRetransmissionEnabled, status.DownstreamRetransmissionEnabled)
+	d.compare("UpstreamRetransmissionEnabled",
+		last.UpstreamRetransmissionEnabled, status.UpstreamRetransmissionEnabled)
+
+	d.compareRetransmission("DownstreamRetransmission", last.DownstreamRetransmission, status.DownstreamRetransmission)
+	d.compareRetransmission("UpstreamRetransmission", last.UpstreamRetransmission, status.UpstreamRetransmission)
+
+	d.compare("DownstreamVectoringState", last.DownstreamVectoringState, status.DownstreamVectoringState)
+	d.compare("UpstreamVectoringState", last.UpstreamVectoringState, status.UpstreamVectoringState)
+	d.compareText("Vectoring.MachineState", last.Vectoring.MachineState, status.Vectoring.MachineState)
+
+	d.compare("DownstreamAttenuation", last.DownstreamAttenuation, status.DownstreamAttenuation)
+	d.compare("UpstreamAttenuation", last.UpstreamAttenuation, status.UpstreamAttenuation)
+
+	d.compare("DownstreamSNRMargin", last.DownstreamSNRMargin, status.DownstreamSNRMargin)
+	d.compare("UpstreamSNRMargin", last.UpstreamSNRMargin, status.UpstreamSNRMargin)
+
+	d.compare("DownstreamPower", last.DownstreamPower, status.DownstreamPower)
+	d.compare("UpstreamPower", last.UpstreamPower, status.UpstreamPower)
+
+	d.compareBands("DownstreamBands", last.DownstreamBands, status.DownstreamBands)
+	d.compareBands("UpstreamBands", last.UpstreamBands, status.UpstreamBands)
+
+	d.compareConfiguration("Configuration", last.Configuration, status.Configuration)
+	d.comparePowerBackOff("PowerBackOff", last.PowerBackOff, status.PowerBackOff)
+
+	d.compareInventory("FarEndInventory", last.FarEndInventory, status.FarEndInventory)
+	d.compareInventory("NearEndInventory", last.NearEndInventory, status.NearEndInventory)
+
+	return d.changes
+}
+
+func (d *statusDiff) compareRetransmission(field string, last, rtx RetransmissionStatus) {
+	d.compare(field+".NetDataRate", last.NetDataRate, rtx.NetDataRate)
+	d.compare(field+".ExpectedThroughput", last.ExpectedThroughput, rtx.ExpectedThroughput)
+	d.compare(field+".ImpulseNoiseProtectionREIN", last.ImpulseNoiseProtectionREIN, rtx.ImpulseNoiseProtectionREIN)
+	d.compare(field+".ImpulseNoiseProtectionSHINE", last.ImpulseNoiseProtectionSHINE, rtx.ImpulseNoiseProtectionSHINE)
+}
+
+// bandKeys returns the names used to match bands, falling back to the index for unnamed bands.
+func bandKeys(count int, name func(int) string) []string {
+	keys := make([]string, count)
+	for i := range keys {
+		keys[i] = name(i)
+		if keys[i] == "" {
+			keys[i] = strconv.Itoa(i)
+		}
+	}
+	return keys
+}
+
+type keyMatch struct {
+	key       string
+	lastIndex int
+	index     int
+}
+
+// matchKeys pairs the entries of two lists by their keys, in order of their first occurrence. Entries which only
+// exist in one of the lists have an index of -1 for the other list.
+func matchKeys(lastKeys, keys []string) []keyMatch {
+	var out []keyMatch
+	positions := make(map[string]int)
+	for i, key := range lastKeys {
+		if _, ok := positions[key]; !ok {
+			positions[key] = len(out)
+			out = append(out, keyMatch{key: key, lastIndex: i, index: -1})
+		}
+	}
+	for i, key := range keys {
+		if pos, ok := positions[key]; ok {
+			if out[pos].index == -1 {
+				out[pos].index = i
+			}
+		} else {
+			positions[key] = len(out)
+			out = append(out, keyMatch{key: key, lastIndex: -1, index: i})
+		}
+	}
+	return out
+}
+
+func (d *statusDiff) compareBands(field string, last, bands []BandStatus) {
+	lastKeys := bandKeys(len(last), func(i int) string { return last[i].Name })
+	keys := bandKeys(len(bands), func(i int) string { return bands[i].Name })
+
+	// bands which only exist on one side are compared against a band without valid values
+	for _, match := range matchKeys(lastKeys, keys) {
+		var lastBand, band BandStatus
+		if match.lastIndex != -1 {
+			lastBand = last[match.lastIndex]
+		}
+		if match.index != -1 {
+			band = bands[match.index]
+		}
+		prefix := field + "." + match.key
+
+		d.compare(prefix+".Attenuation", lastBand.Attenuation, band.Attenuation)
+		d.compare(prefix+".SignalAttenuation", lastBand.SignalAttenuation, band.SignalAttenuation)
+		d.compare(prefix+".SNRMargin", lastBand.SNRMargin, band.SNRMargin)
+		d.compare(prefix+".Power", lastBand.Power, band.Power)
+	}
+}
+
+func (d *statusDiff) compareFraming(field string, last, framing Framing) {
+	d.compare(field+".L", last.L, framing.L)
+	d.compare(field+".D", last.D, framing.D)
+	d.compare(field+".N", last.N, framing.N)
+	d.compare(field+".R", last.R, framing.R)
+	d.compare(field+".I", last.I, framing.I)
+	d.compare(field+".M", last.M, framing.M)
+	d.compare(field+".T", last.T, framing.T)
+}
+
+func (d *statusDiff) compareConfiguration(field string, last, c LineConfiguration) {
+	lastTransportMode, transportMode := "", ""
+	if last.TransportMode != TransportModeUnknown {
+		lastTransportMode = last.TransportMode.String()
+	}
+	if c.TransportMode != TransportModeUnknown {
+		transportMode = c.TransportMode.String()
+	}
+
+	d.compareText(field+".TransportMode", lastTransportMode, transportMode)
+	d.compareText(field+".EnabledXTSE", last.EnabledXTSE.String(), c.EnabledXTSE.String())
+	d.compareText(field+".NegotiatedXTSE", last.NegotiatedXTSE.String(), c.NegotiatedXTSE.String())
+	d.compareText(field+".EnabledModes", last.EnabledModesString(), c.EnabledModesString())
+	d.compareText(field+".EnabledProfiles", last.EnabledProfilesString(), c.EnabledProfilesString())
+	d.compare(field+".DownstreamTrellis", last.DownstreamTrellis, c.DownstreamTrellis)
+	d.compare(field+".UpstreamTrellis", last.UpstreamTrellis, c.UpstreamTrellis)
+	d.compare(field+".DownstreamSNRMode", last.DownstreamSNRMode, c.DownstreamSNRMode)
+	d.compare(field+".UpstreamSNRMode", last.UpstreamSNRMode, c.UpstreamSNRMode)
+	d.compareFraming(field+".DownstreamFraming", last.DownstreamFraming, c.DownstreamFraming)
+	d.compareFraming(field+".UpstreamFraming", last.UpstreamFraming, c.UpstreamFraming)
+}
+
+func (d *statusDiff) comparePowerBackOff(field string, last, p PowerBackOff) {
+	d.compare(field+".UPBOElectricalLength", last.UPBOElectricalLength, p.UPBOElectricalLength)
+
+	lastKeys := bandKeys(len(last.UPBOBands), func(i int) string { return last.UPBOBands[i].Name })
+	keys := bandKeys(len(p.UPBOBands), func(i int) string { return p.UPBOBands[i].Name })
+
+	for _, match := range matchKeys(lastKeys, keys) {
+		var lastBand, band UPBOBand
+		if match.lastIndex != -1 {
+			lastBand = last.UPBOBands[match.lastIndex]
+		}
+		if match.index != -1 {
+			band = p.UPBOBands[match.index]
+		}
+		prefix := field + ".UPBOBands." + match.key
+
+		d.compare(prefix+".A", lastBand.A, band.A)
+		d.compare(prefix+".B", lastBand.B, band.B)
+	}
+
+	d.compare(field+".DPBOElectricalLength", last.DPBOElectricalLength, p.DPBOElectricalLength)
+	d.compare(field+".DPBOMinimumUsableSignal", last.DPBOMinimumUsableSignal, p.DPBOMinimumUsableSignal)
+}
+
+func (d *statusDiff) compareInventory(field string, last, inventory Inventory) {
+	d.compareText(field+".Vendor", last.Vendor, inventory.Vendor)
+	d.compareText(field+".Version", last.Version, inventory.Version)
+	d.compareText(field+".Model", last.Model, inventory.Model)
+	d.compareText(field+".SystemVendor", last.SystemVendor, inventory.SystemVendor)
+	d.compareText(field+".Country", last.Country, inventory.Country)
+	d.compareText(field+".VersionNumber", last.VersionNumber, inventory.VersionNumber)
+	d.compareText(field+".SerialNumber", last.SerialNumber, inventory.SerialNumber)
+	d.compareText(field+".SelfTestResult", last.SelfTest(), inventory.SelfTest())
+}
+
+// counter describes an error counter by its path within Status. If resetOnResync is set, the counter is assumed
+// to be reset when the link resyncs.
+type counter struct {
+	field         string
+	resetOnResync bool
+}
+
+// value returns the value of the counter in the given status.
+func (c counter) value(status *Status) IntValue {
+	v := reflect.ValueOf(status).Elem()
+	for _, name := range strings.Split(c.field, ".") {
+		v = v.FieldByName(name)
+	}
+	return v.Interface().(IntValue)
+}
+
+// counters lists all error counters of Status, which are used by DiffCounters. New counters need to be added here.
+var counters = []counter{
+	{"DownstreamBitswap.Executed", true},
+	{"UpstreamBitswap.Executed", true},
+
+	{"DownstreamSeamlessRateAdaptation.Executed", true},
+	{"UpstreamSeamlessRateAdaptation.Executed", true},
+
+	{"Vectoring.ErrorSampleCount", true},
+	{"Vectoring.DiscardedErrorSampleCount", true},
+
+	{"DownstreamRTXTXCount", true},
+	{"UpstreamRTXTXCount", true},
+
+	{"DownstreamRTXCCount", true},
+	{"UpstreamRTXCCount", true},
+
+	{"DownstreamRTXUCCount", true},
+	{"UpstreamRTXUCCount", true},
+
+	{"DownstreamFECCount", true},
+	{"UpstreamFECCount", true},
+
+	{"DownstreamCRCCount", true},
+	{"UpstreamCRCCount", true},
+
+	{"DownstreamESCount", true},
+	{"UpstreamESCount", true},
+
+	{"DownstreamSESCount", true},
+	{"UpstreamSESCount", true},
+
+	{"DownstreamLOSSCount", true},
+	{"UpstreamLOSSCount", true},
+
+	{"DownstreamLOFSCount", true},
+	{"UpstreamLOFSCount", true},
+
+	{"DownstreamUASCount", true},
+	{"UpstreamUASCount", true},
+
+	{"FullInitCount", false},
+	{"FailedFullInitCount", false},
+
+	{"RetrainCount", false},
+	{"FailedRetrainCount", false},
+}
+
+type counterDiff struct {
+	deltas []CounterDelta
+	resync bool
+}
+
+func (d *counterDiff) add(field string, lastVal, val IntValue, resetOnResync bool) {
+	if !lastVal.Valid && !val.Valid {
+		return
+	}
+
+	delta := CalculateCounterDelta(lastVal, val)
+	if d.resync && resetOnResync {
+		delta = CounterDelta{Reset: true}
+	}
+	delta.Field = field
+
+	d.deltas = append(d.deltas, delta)
+}
+
+// DiffCounters returns the increase of all error counters between two status values. Counters which are invalid
+// in both values are omitted. If the uptime has decreased, a resync is assumed and all counters except for the
+// initialization and retrain counters are reported as reset.
+func DiffCounters(last, status Status) []CounterDelta {
+	var d counterDiff
+
+	d.resync = last.Uptime.Valid && status.Uptime.Valid && last.Uptime.Duration > status.Uptime.Duration
+
+	for _, c := range counters {
+		d.add(c.field, c.value(&last), c.value(&status), c.resetOnResync)
+	}
+
+	return d.deltas
+}
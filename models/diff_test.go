@@ -0,0 +1,103 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package models
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCalculateCounterDelta(t *testing.T) {
+	valid := func(val int64) IntValue {
+		return IntValue{Int: val, Valid: true}
+	}
+
+	tests := []struct {
+		name     string
+		lastVal  IntValue
+		val      IntValue
+		expected CounterDelta
+	}{
+		{"increase", valid(100), valid(150), CounterDelta{Delta: valid(50)}},
+		{"unchanged", valid(100), valid(100), CounterDelta{Delta: valid(0)}},
+		{"invalid last value", IntValue{}, valid(100), CounterDelta{}},
+		{"invalid value", valid(100), IntValue{}, CounterDelta{}},
+		{"wrap at 32 bits", valid(1<<32 - 10), valid(5), CounterDelta{Delta: valid(15), Wrapped: true}},
+		{"wrap from maximum", valid(1<<32 - 1), valid(0), CounterDelta{Delta: valid(1), Wrapped: true}},
+		{"reset after retrain", valid(1000), valid(3), CounterDelta{Reset: true}},
+		{"reset to zero", valid(1000), valid(0), CounterDelta{Reset: true}},
+		{"reset of value above 32 bits", valid(1 << 33), valid(5), CounterDelta{Reset: true}},
+		{"unreasonably large increase", valid(3), valid(1<<32 - 3), CounterDelta{}},
+	}
+
+	for _, test := range tests {
+		delta := CalculateCounterDelta(test.lastVal, test.val)
+		if delta != test.expected {
+			t.Errorf("%s: got %+v, expected %+v", test.name, delta, test.expected)
+		}
+	}
+}
+
+// collectCounterFields returns the paths of all counters within the given struct type, which are identified by
+// their name.
+func collectCounterFields(typ reflect.Type, prefix string) (fields []string) {
+	intValueType := reflect.TypeOf(IntValue{})
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		switch {
+		case field.Type == intValueType:
+			if strings.HasSuffix(field.Name, "Count") || field.Name == "Executed" {
+				fields = append(fields, prefix+field.Name)
+			}
+		case field.Type.Kind() == reflect.Struct:
+			fields = append(fields, collectCounterFields(field.Type, prefix+field.Name+".")...)
+		}
+	}
+
+	return
+}
+
+func TestCountersComplete(t *testing.T) {
+	expected := collectCounterFields(reflect.TypeOf(Status{}), "")
+
+	var fields []string
+	for _, c := range counters {
+		fields = append(fields, c.field)
+	}
+
+	sort.Strings(expected)
+	sort.Strings(fields)
+
+	if strings.Join(fields, ",") != strings.Join(expected, ",") {
+		t.Errorf("counters do not match fields of Status:\n got      %v\n expected %v", fields, expected)
+	}
+}
+
+func TestDiffCountersResync(t *testing.T) {
+	var last, status Status
+
+	last.Uptime = Duration{Valid: true, Duration: time.Hour}
+	last.DownstreamCRCCount = IntValue{Int: 100, Valid: true}
+	last.FullInitCount = IntValue{Int: 2, Valid: true}
+
+	status.Uptime = Duration{Valid: true, Duration: time.Minute}
+	status.DownstreamCRCCount = IntValue{Int: 150, Valid: true}
+	status.FullInitCount = IntValue{Int: 3, Valid: true}
+
+	expected := []CounterDelta{
+		{Field: "DownstreamCRCCount", Reset: true},
+		{Field: "FullInitCount", Delta: IntValue{Int: 1, Valid: true}},
+	}
+
+	deltas := DiffCounters(last, status)
+	if !reflect.DeepEqual(deltas, expected) {
+		t.Errorf("got %+v, expected %+v", deltas, expected)
+	}
+}
@@ -54,6 +54,24 @@ type ErrorsHistory struct {
 
 	DownstreamSESCount []IntValue
 	UpstreamSESCount   []IntValue
+
+	DownstreamLOSSCount []IntValue
+	UpstreamLOSSCount   []IntValue
+
+	DownstreamLOFSCount []IntValue
+	UpstreamLOFSCount   []IntValue
+
+	DownstreamUASCount []IntValue
+	UpstreamUASCount   []IntValue
+
+	FullInitCount       []IntValue
+	FailedFullInitCount []IntValue
+
+	RetrainCount       []IntValue
+	FailedRetrainCount []IntValue
+
+	// LinkLossCount counts the losses of link (LOL), i.e. drops from showtime and resyncs between two updates
+	LinkLossCount []IntValue
 }
 
 func (h ErrorsHistory) String() string {
@@ -87,6 +105,23 @@ func (h ErrorsHistory) String() string {
 	printErrorsHistoryList(&b, "Downstream SES", h.DownstreamSESCount)
 	printErrorsHistoryList(&b, "Upstream SES", h.UpstreamSESCount)
 
+	printErrorsHistoryList(&b, "Downstream LOSS", h.DownstreamLOSSCount)
+	printErrorsHistoryList(&b, "Upstream LOSS", h.UpstreamLOSSCount)
+
+	printErrorsHistoryList(&b, "Downstream LOFS", h.DownstreamLOFSCount)
+	printErrorsHistoryList(&b, "Upstream LOFS", h.UpstreamLOFSCount)
+
+	printErrorsHistoryList(&b, "Downstream UAS", h.DownstreamUASCount)
+	printErrorsHistoryList(&b, "Upstream UAS", h.UpstreamUASCount)
+
+	printErrorsHistoryList(&b, "Full inits", h.FullInitCount)
+	printErrorsHistoryList(&b, "Failed inits", h.FailedFullInitCount)
+
+	printErrorsHistoryList(&b, "Retrains", h.RetrainCount)
+	printErrorsHistoryList(&b, "Failed retrains", h.FailedRetrainCount)
+
+	printErrorsHistoryList(&b, "Link losses", h.LinkLossCount)
+
 	return b.String()
 }
 
@@ -4,9 +4,26 @@
 
 package models
 
+import (
+	"fmt"
+)
+
 type Inventory struct {
 	Vendor  string
 	Version string
+
+	// Model is the chipset or device model, if it is known or could be derived from vendor and version
+	Model string
+
+	// SystemVendor is the vendor of the whole system, which may differ from the chipset vendor
+	SystemVendor string
+
+	// Country is the country of the vendor ID according to ITU-T T.35
+	Country string
+
+	VersionNumber  string
+	SerialNumber   string
+	SelfTestResult IntValue
 }
 
 func (i Inventory) String() string {
@@ -21,3 +38,14 @@ func (i Inventory) String() string {
 	}
 	return output
 }
+
+// SelfTest returns a description of the self-test result, or an empty string if it is not available.
+func (i Inventory) SelfTest() string {
+	if !i.SelfTestResult.Valid {
+		return ""
+	}
+	if i.SelfTestResult.Int == 0 {
+		return "passed"
+	}
+	return fmt.Sprintf("failed (0x%08x)", i.SelfTestResult.Int)
+}
@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Line contains the data of a single line (pair) of a device, which may have multiple lines in case of bonding
+type Line struct {
+	Status Status
+	Bins   Bins
+}
+
+// BondingStatus summarizes the lines of a bonding group
+type BondingStatus struct {
+	LineCount     int
+	ShowtimeCount int
+
+	// the rates are the sums of the rates of all lines in showtime
+	DownstreamActualRate     ValueBandwidth
+	UpstreamActualRate       ValueBandwidth
+	DownstreamAttainableRate ValueBandwidth
+	UpstreamAttainableRate   ValueBandwidth
+}
+
+func NewBondingStatus(lines []Line) BondingStatus {
+	b := BondingStatus{LineCount: len(lines)}
+
+	for _, line := range lines {
+		if line.Status.State != StateShowtime {
+			continue
+		}
+
+		b.ShowtimeCount++
+
+		addBondingRate(&b.DownstreamActualRate, line.Status.DownstreamActualRate)
+		addBondingRate(&b.UpstreamActualRate, line.Status.UpstreamActualRate)
+		addBondingRate(&b.DownstreamAttainableRate, line.Status.DownstreamAttainableRate)
+		addBondingRate(&b.UpstreamAttainableRate, line.Status.UpstreamAttainableRate)
+	}
+
+	return b
+}
+
+func addBondingRate(sum *ValueBandwidth, val ValueBandwidth) {
+	if val.Valid {
+		sum.Int += val.Int
+		sum.Valid = true
+	}
+}
+
+func (b BondingStatus) Summary() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "         Bonding:    %d of %d lines in showtime\n", b.ShowtimeCount, b.LineCount)
+	fmt.Fprintln(&sb)
+
+	printValues(&sb, "Actual rate", b.DownstreamActualRate, b.UpstreamActualRate)
+	printValues(&sb, "Attainable rate", b.DownstreamAttainableRate, b.UpstreamAttainableRate)
+
+	return sb.String()
+}
@@ -0,0 +1,41 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package models
+
+// PowerBackOff contains the parameters of upstream and downstream power back-off (UPBO and DPBO)
+type PowerBackOff struct {
+	// UPBOElectricalLength is the electrical length kl0 which is used for UPBO according to G.993.2
+	UPBOElectricalLength ValueDecibel
+
+	// UPBOBands contains the parameters of the reference PSD for each upstream band
+	UPBOBands []UPBOBand
+
+	// DPBOElectricalLength is the electrical length of the cable from the exchange to the cabinet (DPBOESEL)
+	DPBOElectricalLength ValueDecibel
+
+	// DPBOMinimumUsableSignal is the minimum usable signal level of the exchange signal (DPBOMUS)
+	DPBOMinimumUsableSignal ValuePSD
+}
+
+// IsValid returns true if at least one value is available.
+func (p PowerBackOff) IsValid() bool {
+	return p.UPBOElectricalLength.Valid || len(p.UPBOBands) != 0 ||
+		p.DPBOElectricalLength.Valid || p.DPBOMinimumUsableSignal.Valid
+}
+
+// UPBOBand contains the UPBO parameters of a single upstream band, the reference PSD is defined as
+// -a-b*sqrt(f) with f in MHz
+type UPBOBand struct {
+	// Name is the name of the band as used in the standards, such as U1
+	Name string
+
+	A ValuePSD
+	B ValueDecibel
+}
+
+// IsValid returns true if at least one value is available for the band.
+func (b UPBOBand) IsValid() bool {
+	return b.A.Valid || b.B.Valid
+}
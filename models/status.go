@@ -39,9 +39,14 @@ type Status struct {
 	DownstreamRetransmissionEnabled BoolValue
 	UpstreamRetransmissionEnabled   BoolValue
 
+	DownstreamRetransmission RetransmissionStatus
+	UpstreamRetransmission   RetransmissionStatus
+
 	DownstreamVectoringState VectoringValue
 	UpstreamVectoringState   VectoringValue
 
+	Vectoring VectoringDiagnostics
+
 	DownstreamAttenuation ValueDecibel
 	UpstreamAttenuation   ValueDecibel
 
@@ -51,6 +56,10 @@ type Status struct {
 	DownstreamPower ValuePower
 	UpstreamPower   ValuePower
 
+	// DownstreamBands and UpstreamBands contain the line parameters per band, as reported for VDSL2
+	DownstreamBands []BandStatus
+	UpstreamBands   []BandStatus
+
 	DownstreamRTXTXCount IntValue
 	UpstreamRTXTXCount   IntValue
 
@@ -72,10 +81,63 @@ type Status struct {
 	DownstreamSESCount IntValue
 	UpstreamSESCount   IntValue
 
+	DownstreamLOSSCount IntValue
+	UpstreamLOSSCount   IntValue
+
+	DownstreamLOFSCount IntValue
+	UpstreamLOFSCount   IntValue
+
+	DownstreamUASCount IntValue
+	UpstreamUASCount   IntValue
+
+	// FullInitCount and FailedFullInitCount count the (failed) full initializations of the link, in contrast
+	// to the other counters they are usually not reset on resync
+	FullInitCount       IntValue
+	FailedFullInitCount IntValue
+
+	// RetrainCount and FailedRetrainCount count the (failed) retrains of the link as reported by some devices
+	// (such as Broadcom), these are not necessarily full initializations according to G.997.1
+	RetrainCount       IntValue
+	FailedRetrainCount IntValue
+
+	Configuration LineConfiguration
+
+	PowerBackOff PowerBackOff
+
 	FarEndInventory  Inventory
 	NearEndInventory Inventory
 }
 
+// RetransmissionStatus contains the parameters of retransmission according to G.998.4 (G.INP)
+type RetransmissionStatus struct {
+	NetDataRate        ValueBandwidth // NDR
+	ExpectedThroughput ValueBandwidth // ETR
+
+	ImpulseNoiseProtectionREIN  ValueSymbols // INP_act_REIN
+	ImpulseNoiseProtectionSHINE ValueSymbols // INP_act_SHINE
+}
+
+// IsValid returns true if at least one value is available.
+func (r RetransmissionStatus) IsValid() bool {
+	return r.NetDataRate.Valid || r.ExpectedThroughput.Valid ||
+		r.ImpulseNoiseProtectionREIN.Valid || r.ImpulseNoiseProtectionSHINE.Valid
+}
+
+type BandStatus struct {
+	// Name is the name of the band as used in the standards, such as U0 or D1
+	Name string
+
+	Attenuation       ValueDecibel
+	SignalAttenuation ValueDecibel
+	SNRMargin         ValueDecibel
+	Power             ValuePower
+}
+
+// IsValid returns true if at least one value is available for the band.
+func (b BandStatus) IsValid() bool {
+	return b.Attenuation.Valid || b.SignalAttenuation.Valid || b.SNRMargin.Valid || b.Power.Valid
+}
+
 func (s Status) Summary() string {
 	var b strings.Builder
 
@@ -85,9 +147,16 @@ func (s Status) Summary() string {
 	fmt.Fprintln(&b)
 
 	fmt.Fprintf(&b, "          Remote:    %s\n", s.FarEndInventory)
+	printInventoryDetails(&b, s.FarEndInventory)
 	fmt.Fprintf(&b, "           Modem:    %s\n", s.NearEndInventory)
+	printInventoryDetails(&b, s.NearEndInventory)
 	fmt.Fprintln(&b)
 
+	if s.Configuration.IsValid() {
+		printConfiguration(&b, s.Configuration)
+		fmt.Fprintln(&b)
+	}
+
 	printValues(&b, "Actual rate", s.DownstreamActualRate, s.UpstreamActualRate)
 	printValues(&b, "Attainable rate", s.DownstreamAttainableRate, s.UpstreamAttainableRate)
 	printValues(&b, "MINEFTR", s.DownstreamMinimumErrorFreeThroughput, s.UpstreamMinimumErrorFreeThroughput)
@@ -102,7 +171,28 @@ func (s Status) Summary() string {
 	printValues(&b, "Retransmission", s.DownstreamRetransmissionEnabled, s.UpstreamRetransmissionEnabled)
 	fmt.Fprintln(&b)
 
+	if s.DownstreamRetransmission.IsValid() || s.UpstreamRetransmission.IsValid() {
+		ds, us := s.DownstreamRetransmission, s.UpstreamRetransmission
+		printValues(&b, "NDR", ds.NetDataRate, us.NetDataRate)
+		printValues(&b, "ETR", ds.ExpectedThroughput, us.ExpectedThroughput)
+		printValues(&b, "INP REIN", ds.ImpulseNoiseProtectionREIN, us.ImpulseNoiseProtectionREIN)
+		printValues(&b, "INP SHINE", ds.ImpulseNoiseProtectionSHINE, us.ImpulseNoiseProtectionSHINE)
+		fmt.Fprintln(&b)
+	}
+
+	notesDown, notesUp := s.DownstreamRateNotes(), s.UpstreamRateNotes()
+	if len(notesDown) != 0 || len(notesUp) != 0 {
+		printNotes(&b, "Downstream", notesDown)
+		printNotes(&b, "Upstream", notesUp)
+		fmt.Fprintln(&b)
+	}
+
 	printValues(&b, "Vectoring", s.DownstreamVectoringState, s.UpstreamVectoringState)
+	if s.Vectoring.IsValid() {
+		printDetail(&b, "Vectoring state", s.Vectoring.MachineState)
+		printValue(&b, "Error samples", s.Vectoring.ErrorSampleCount)
+		printValue(&b, "Discarded samples", s.Vectoring.DiscardedErrorSampleCount)
+	}
 	fmt.Fprintln(&b)
 
 	printValues(&b, "Attenuation", s.DownstreamAttenuation, s.UpstreamAttenuation)
@@ -110,6 +200,22 @@ func (s Status) Summary() string {
 	printValues(&b, "Transmit power", s.DownstreamPower, s.UpstreamPower)
 	fmt.Fprintln(&b)
 
+	if len(s.DownstreamBands) != 0 || len(s.UpstreamBands) != 0 {
+		fmt.Fprintf(&b, "%16s     %8s %-3s  %8s %-3s  %8s %-3s  %8s %-3s\n", "Band", "LATN", "", "SATN", "", "SNRM", "", "TX power", "")
+		for _, band := range s.DownstreamBands {
+			printBandValues(&b, band)
+		}
+		for _, band := range s.UpstreamBands {
+			printBandValues(&b, band)
+		}
+		fmt.Fprintln(&b)
+	}
+
+	if s.PowerBackOff.IsValid() {
+		printPowerBackOff(&b, s.PowerBackOff)
+		fmt.Fprintln(&b)
+	}
+
 	printValues(&b, "RTX TX Count", s.DownstreamRTXTXCount, s.UpstreamRTXTXCount)
 	printValues(&b, "RTX C Count", s.DownstreamRTXCCount, s.UpstreamRTXCCount)
 	printValues(&b, "RTX UC Count", s.DownstreamRTXUCCount, s.UpstreamRTXUCCount)
@@ -121,10 +227,143 @@ func (s Status) Summary() string {
 
 	printValues(&b, "ES Count", s.DownstreamESCount, s.UpstreamESCount)
 	printValues(&b, "SES Count", s.DownstreamSESCount, s.UpstreamSESCount)
+	fmt.Fprintln(&b)
+
+	printValues(&b, "LOSS Count", s.DownstreamLOSSCount, s.UpstreamLOSSCount)
+	printValues(&b, "LOFS Count", s.DownstreamLOFSCount, s.UpstreamLOFSCount)
+	printValues(&b, "UAS Count", s.DownstreamUASCount, s.UpstreamUASCount)
+	fmt.Fprintln(&b)
+
+	printValue(&b, "Full inits", s.FullInitCount)
+	printValue(&b, "Failed inits", s.FailedFullInitCount)
+	if s.RetrainCount.Valid || s.FailedRetrainCount.Valid {
+		printValue(&b, "Retrains", s.RetrainCount)
+		printValue(&b, "Failed retrains", s.FailedRetrainCount)
+	}
 
 	return b.String()
 }
 
+// DownstreamRateNotes returns hints on what limits the downstream rate.
+func (s Status) DownstreamRateNotes() []string {
+	return rateNotes(s.DownstreamActualRate, s.DownstreamAttainableRate,
+		s.DownstreamRetransmissionEnabled, s.DownstreamRetransmission)
+}
+
+// UpstreamRateNotes returns hints on what limits the upstream rate.
+func (s Status) UpstreamRateNotes() []string {
+	return rateNotes(s.UpstreamActualRate, s.UpstreamAttainableRate,
+		s.UpstreamRetransmissionEnabled, s.UpstreamRetransmission)
+}
+
+// attainableRateThreshold is the fraction of the attainable rate below which the actual rate is assumed to be limited
+// by the line profile instead of the line quality. This is only a heuristic, as the attainable rate is estimated
+// differently depending on the chipset.
+const attainableRateThreshold = 0.95
+
+func rateNotes(actual, attainable ValueBandwidth, rtxEnabled BoolValue, rtx RetransmissionStatus) (notes []string) {
+	if actual.Valid && attainable.Valid && actual.Int > 0 && attainable.Int > 0 {
+		if float64(actual.Int) < attainableRateThreshold*float64(attainable.Int) {
+			notes = append(notes, fmt.Sprintf("rate is possibly limited by the line profile, the attainable rate is %.0f%% higher",
+				100*(float64(attainable.Int)/float64(actual.Int)-1)))
+		} else {
+			notes = append(notes, "rate is possibly limited by the line quality, as it is close to the attainable rate")
+		}
+	}
+
+	if !rtxEnabled.Valid || !rtxEnabled.Bool {
+		return
+	}
+
+	if rtx.NetDataRate.Valid && rtx.ExpectedThroughput.Valid && rtx.ExpectedThroughput.Int < rtx.NetDataRate.Int {
+		notes = append(notes, fmt.Sprintf("retransmission overhead reduces the throughput by %.1f%% (ETR compared to NDR)",
+			100*(1-float64(rtx.ExpectedThroughput.Int)/float64(rtx.NetDataRate.Int))))
+	}
+
+	if rtx.ImpulseNoiseProtectionREIN.Valid && rtx.ImpulseNoiseProtectionREIN.Float > 0 {
+		notes = append(notes, "protection against repetitive impulse noise (REIN) is active and reduces the rate")
+	}
+
+	return
+}
+
+func printNotes(w io.Writer, label string, notes []string) {
+	for i, note := range notes {
+		if i == 0 {
+			fmt.Fprintf(w, "%16s:    %s\n", label, note)
+		} else {
+			fmt.Fprintf(w, "%16s     %s\n", "", note)
+		}
+	}
+}
+
+func printConfiguration(w io.Writer, c LineConfiguration) {
+	if c.TransportMode != TransportModeUnknown {
+		printDetail(w, "Transport mode", c.TransportMode.String())
+	}
+	printDetail(w, "Enabled modes", c.EnabledModesString())
+	printDetail(w, "Enabled profiles", c.EnabledProfilesString())
+	printDetail(w, "Enabled XTSE", c.EnabledXTSE.String())
+	printDetail(w, "Negotiated XTSE", c.NegotiatedXTSE.String())
+	printValues(w, "Trellis", c.DownstreamTrellis, c.UpstreamTrellis)
+	printValues(w, "SNR mode", c.DownstreamSNRMode, c.UpstreamSNRMode)
+
+	if c.DownstreamFraming.IsValid() || c.UpstreamFraming.IsValid() {
+		ds, us := c.DownstreamFraming, c.UpstreamFraming
+		printValues(w, "Framing L", ds.L, us.L)
+		printValues(w, "Framing D", ds.D, us.D)
+		printValues(w, "Framing N", ds.N, us.N)
+		printValues(w, "Framing R", ds.R, us.R)
+		printValues(w, "Framing I", ds.I, us.I)
+		printValues(w, "Framing M", ds.M, us.M)
+		printValues(w, "Framing T", ds.T, us.T)
+	}
+}
+
+func printPowerBackOff(w io.Writer, p PowerBackOff) {
+	if p.UPBOElectricalLength.Valid {
+		printValue(w, "UPBO kl0", p.UPBOElectricalLength)
+	}
+	for _, band := range p.UPBOBands {
+		fmt.Fprintf(w, "%16s:    %8s %-7s  %8s %-7s\n", "UPBO "+band.Name,
+			band.A.Value(), band.A.Unit(), band.B.Value(), band.B.Unit())
+	}
+	if p.DPBOElectricalLength.Valid {
+		printValue(w, "DPBO ESEL", p.DPBOElectricalLength)
+	}
+	if p.DPBOMinimumUsableSignal.Valid {
+		printValue(w, "DPBO MUS", p.DPBOMinimumUsableSignal)
+	}
+}
+
+func printInventoryDetails(w io.Writer, inventory Inventory) {
+	printDetail(w, "Model", inventory.Model)
+	printDetail(w, "System vendor", inventory.SystemVendor)
+	printDetail(w, "Country", inventory.Country)
+	printDetail(w, "Version number", inventory.VersionNumber)
+	printDetail(w, "Serial number", inventory.SerialNumber)
+	printDetail(w, "Self-test", inventory.SelfTest())
+}
+
+// printDetail prints a textual value, which is left out if it is empty
+func printDetail(w io.Writer, label, val string) {
+	if val != "" {
+		fmt.Fprintf(w, "%16s:    %s\n", label, val)
+	}
+}
+
+func printBandValues(w io.Writer, band BandStatus) {
+	fmt.Fprintf(w, "%16s:    %8s %-3s  %8s %-3s  %8s %-3s  %8s %-3s\n", band.Name,
+		band.Attenuation.Value(), band.Attenuation.Unit(),
+		band.SignalAttenuation.Value(), band.SignalAttenuation.Unit(),
+		band.SNRMargin.Value(), band.SNRMargin.Unit(),
+		band.Power.Value(), band.Power.Unit())
+}
+
+func printValue(w io.Writer, label string, val Value) {
+	fmt.Fprintf(w, "%16s:    %8s %-7s\n", label, val.Value(), val.Unit())
+}
+
 func printValues(w io.Writer, label string, valDown, valUp Value) {
 	fmt.Fprintf(w, "%16s:    %8s %-7s  %8s %-7s\n", label, valDown.Value(), valDown.Unit(), valUp.Value(), valUp.Unit())
 }
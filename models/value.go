@@ -155,6 +155,18 @@ func (v ValuePower) Unit() string {
 	return "dBm"
 }
 
+type ValuePSD struct {
+	FloatValue
+}
+
+func (v ValuePSD) String() string {
+	return v.FloatValue.String() + " " + v.Unit()
+}
+
+func (v ValuePSD) Unit() string {
+	return "dBm/Hz"
+}
+
 type ValueMilliseconds struct {
 	FloatValue
 }
@@ -23,3 +23,20 @@ func (v VectoringState) String() string {
 	}
 	return ""
 }
+
+// VectoringDiagnostics contains diagnostic data of vectoring according to G.993.5
+type VectoringDiagnostics struct {
+	// MachineState is the state of the vectoring state machine of the modem, as reported by the device
+	MachineState string
+
+	// ErrorSampleCount is the number of error samples (backchannel reports) sent to the vectoring control entity
+	ErrorSampleCount IntValue
+
+	// DiscardedErrorSampleCount is the number of error samples which were discarded instead of being sent
+	DiscardedErrorSampleCount IntValue
+}
+
+// IsValid returns true if at least one value is available.
+func (v VectoringDiagnostics) IsValid() bool {
+	return v.MachineState != "" || v.ErrorSampleCount.Valid || v.DiscardedErrorSampleCount.Valid
+}
@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package openwrt
+
+import (
+	"3e8.eu/go/dsl/internal/helpers"
+	"3e8.eu/go/dsl/models"
+)
+
+func interpretBins(status *models.Status, s *statistics) models.Bins {
+	var bins models.Bins
+
+	bins.Mode = status.Mode
+
+	bins.PilotTones = s.PilotTones
+
+	interpretBinsBits(&bins.Bits.Downstream, &s.Bits.Downstream)
+	interpretBinsBits(&bins.Bits.Upstream, &s.Bits.Upstream)
+
+	interpretBinsFloat(&bins.SNR.Downstream, &s.SNR.Downstream, -32.5)
+	interpretBinsFloat(&bins.SNR.Upstream, &s.SNR.Upstream, -32.5)
+
+	interpretBinsFloat(&bins.QLN.Downstream, &s.QLN.Downstream, -150.5)
+	interpretBinsFloat(&bins.QLN.Upstream, &s.QLN.Upstream, -150.5)
+
+	interpretBinsFloat(&bins.Hlog.Downstream, &s.Hlog.Downstream, -96.3)
+	interpretBinsFloat(&bins.Hlog.Upstream, &s.Hlog.Upstream, -96.3)
+
+	helpers.GenerateBandsData(&bins)
+
+	return bins
+}
+
+func interpretBinsBits(out *models.BinsBits, data *statisticsDataItem) {
+	out.Data = make([]int8, len(data.Data))
+
+	for i, val := range data.Data {
+		if val != nil {
+			out.Data[i] = int8(*val)
+		}
+	}
+}
+
+func interpretBinsFloat(out *models.BinsFloat, data *statisticsDataItem, invalidValue float64) {
+	if len(data.Data) == 0 {
+		return
+	}
+
+	out.GroupSize = data.GroupSize
+	if out.GroupSize == 0 {
+		out.GroupSize = 1
+	}
+
+	out.Data = make([]float64, len(data.Data))
+
+	for i, val := range data.Data {
+		if val != nil {
+			out.Data[i] = *val
+		} else {
+			out.Data[i] = invalidValue
+		}
+	}
+}
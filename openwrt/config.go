@@ -0,0 +1,27 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package openwrt
+
+import (
+	"3e8.eu/go/dsl"
+)
+
+type SSHConfig struct {
+	Host           string
+	User           string
+	Password       dsl.PasswordCallback
+	PrivateKeys    dsl.PrivateKeysCallback
+	SSHAgent       bool
+	KnownHosts     string
+	UnknownHostKey dsl.UnknownHostKeyCallback
+	JumpHost       *dsl.JumpHostConfig
+}
+
+type HTTPConfig struct {
+	Host          string
+	User          string
+	Password      dsl.PasswordCallback
+	TLSSkipVerify bool
+}
@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package openwrt
+
+import (
+	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/models"
+)
+
+type httpClient struct {
+	session *session
+	rawData []byte
+	status  models.Status
+	bins    models.Bins
+}
+
+func NewHTTPClient(config HTTPConfig) (dsl.Client, error) {
+	c := httpClient{}
+
+	var err error
+
+	user := config.User
+	if user == "" {
+		user = "root"
+	}
+
+	c.session, err = newSession(config.Host, user, config.Password, config.TLSSkipVerify)
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (c *httpClient) RawData() []byte {
+	return c.rawData
+}
+
+func (c *httpClient) Status() models.Status {
+	return c.status
+}
+
+func (c *httpClient) Bins() models.Bins {
+	return c.bins
+}
+
+func (c *httpClient) UpdateData() (err error) {
+	c.status, c.bins, c.rawData, err = updateData(c.session)
+	return
+}
+
+func (c *httpClient) Close() {
+	c.session.close()
+}
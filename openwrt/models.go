@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package openwrt
+
+type metrics struct {
+	APIVersion      string           `json:"api_version"`
+	FirmwareVersion string           `json:"firmware_version"`
+	Chipset         string           `json:"chipset"`
+	DriverVersion   string           `json:"driver_version"`
+	State           string           `json:"state"`
+	Up              *bool            `json:"up"`
+	Uptime          *int64           `json:"uptime"`
+	ATUC            metricsATUC      `json:"atu_c"`
+	Annex           string           `json:"annex"`
+	Standard        string           `json:"standard"`
+	Profile         string           `json:"profile"`
+	Mode            string           `json:"mode"`
+	Upstream        metricsDirection `json:"upstream"`
+	Downstream      metricsDirection `json:"downstream"`
+	Errors          metricsErrors    `json:"errors"`
+}
+
+type metricsATUC struct {
+	VendorID       []int  `json:"vendor_id"`
+	Vendor         string `json:"vendor"`
+	SystemVendorID []int  `json:"system_vendor_id"`
+	SystemVendor   string `json:"system_vendor"`
+}
+
+type metricsDirection struct {
+	Vector                     *bool    `json:"vector"`
+	Trellis                    *bool    `json:"trellis"`
+	Bitswap                    *bool    `json:"bitswap"`
+	Retx                       *bool    `json:"retx"`
+	VirtualNoise               *bool    `json:"virtual_noise"`
+	InterleaveDelay            *int64   `json:"interleave_delay"` // µs
+	DataRate                   *int64   `json:"data_rate"`        // bit/s
+	LATN                       *float64 `json:"latn"`
+	SATN                       *float64 `json:"satn"`
+	SNR                        *float64 `json:"snr"`
+	ACTPS                      *float64 `json:"actps"`
+	ACTATP                     *float64 `json:"actatp"`
+	ATTNDR                     *int64   `json:"attndr"`                        // bit/s
+	MinimumErrorFreeThroughput *int64   `json:"minimum_error_free_throughput"` // bit/s
+}
+
+type metricsErrors struct {
+	Near metricsErrorCounters `json:"near"`
+	Far  metricsErrorCounters `json:"far"`
+}
+
+type metricsErrorCounters struct {
+	ES                     *int64 `json:"es"`
+	SES                    *int64 `json:"ses"`
+	LOSS                   *int64 `json:"loss"`
+	UAS                    *int64 `json:"uas"`
+	LOFS                   *int64 `json:"lofs"`
+	FECS                   *int64 `json:"fecs"`
+	CVC                    *int64 `json:"cv_c"`
+	FECC                   *int64 `json:"fec_c"`
+	RxCorrupted            *int64 `json:"rx_corrupted"`
+	RxUncorrectedProtected *int64 `json:"rx_uncorrected_protected"`
+	RxRetransmitted        *int64 `json:"rx_retransmitted"`
+	RxCorrected            *int64 `json:"rx_corrected"`
+	TxRetransmitted        *int64 `json:"tx_retransmitted"`
+}
+
+type statistics struct {
+	Bits       statisticsData `json:"bits"`
+	SNR        statisticsData `json:"snr"`
+	QLN        statisticsData `json:"qln"`
+	Hlog       statisticsData `json:"hlog"`
+	PilotTones []int          `json:"pilot_tones"`
+}
+
+type statisticsData struct {
+	Downstream statisticsDataItem `json:"downstream"`
+	Upstream   statisticsDataItem `json:"upstream"`
+}
+
+type statisticsDataItem struct {
+	GroupSize int        `json:"groupsize"`
+	Groups    int        `json:"groups"`
+	Data      []*float64 `json:"data"`
+}
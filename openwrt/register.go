@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package openwrt
+
+import (
+	"3e8.eu/go/dsl"
+)
+
+func init() {
+	newSSH := func(config dsl.Config) (dsl.Client, error) {
+		sshConfig := SSHConfig{
+			Host:           config.Host,
+			User:           config.User,
+			Password:       config.AuthPassword,
+			PrivateKeys:    config.AuthPrivateKeys,
+			SSHAgent:       config.AuthSSHAgent,
+			KnownHosts:     config.KnownHosts,
+			UnknownHostKey: config.UnknownHostKey,
+			JumpHost:       config.JumpHost,
+		}
+		return NewSSHClient(sshConfig)
+	}
+	clientDescSSH := dsl.ClientDesc{
+		Title:              "OpenWrt (SSH)",
+		RequiresUser:       dsl.TristateYes,
+		SupportedAuthTypes: dsl.AuthTypePassword | dsl.AuthTypePrivateKeys | dsl.AuthTypeSSHAgent,
+		RequiresKnownHosts: true,
+		SupportsJumpHost:   true,
+	}
+	dsl.RegisterClient("openwrt_ssh", newSSH, clientDescSSH)
+
+	newHTTP := func(config dsl.Config) (dsl.Client, error) {
+		httpConfig := HTTPConfig{
+			Host:          config.Host,
+			User:          config.User,
+			Password:      config.AuthPassword,
+			TLSSkipVerify: config.Options["TLSSkipVerify"] == "1",
+		}
+		return NewHTTPClient(httpConfig)
+	}
+	clientDescHTTP := dsl.ClientDesc{
+		Title:              "OpenWrt (HTTP)",
+		RequiresUser:       dsl.TristateMaybe,
+		SupportedAuthTypes: dsl.AuthTypePassword,
+		Options: map[string]dsl.Option{
+			"TLSSkipVerify": dsl.Option{
+				Description: "skip verification of TLS certificates",
+				Type:        dsl.OptionTypeBool,
+			},
+		},
+	}
+	dsl.RegisterClient("openwrt_http", newHTTP, clientDescHTTP)
+}
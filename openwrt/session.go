@@ -0,0 +1,225 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package openwrt
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"3e8.eu/go/dsl"
+)
+
+const nullSessionID = "00000000000000000000000000000000"
+
+const (
+	ubusStatusOK               = 0
+	ubusStatusNotFound         = 4
+	ubusStatusPermissionDenied = 6
+)
+
+// JSON-RPC error code used by rpcd for invalid or expired sessions
+const jsonrpcAccessDenied = -32002
+
+type session struct {
+	host           string
+	client         *http.Client
+	requestCounter uint32
+	sessionID      string
+}
+
+type jsonrpcRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint32        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type jsonrpcResponse struct {
+	JSONRPC string            `json:"jsonrpc"`
+	ID      uint32            `json:"id"`
+	Result  []json.RawMessage `json:"result"`
+	Error   *jsonrpcError     `json:"error"`
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type loginResult struct {
+	SessionID string `json:"ubus_rpc_session"`
+}
+
+type ubusError struct {
+	Status int
+}
+
+func (e *ubusError) Error() string {
+	return fmt.Sprintf("ubus call failed with status %d", e.Status)
+}
+
+func newSession(host, username string, passwordCallback dsl.PasswordCallback, tlsSkipVerify bool) (*session, error) {
+	s := session{}
+
+	if !strings.HasPrefix(host, "http://") && !strings.HasPrefix(host, "https://") {
+		host = "http://" + host
+	}
+	if host[len(host)-1] == '/' {
+		host = host[:len(host)-1]
+	}
+	if strings.Count(host, "/") != 2 {
+		return nil, errors.New("invalid host")
+	}
+	s.host = host
+
+	s.client = &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: tlsSkipVerify},
+		},
+	}
+
+	password, err := passwordCallback()
+	if err != nil {
+		return nil, &dsl.AuthenticationError{Err: err}
+	}
+
+	err = s.login(username, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (s *session) login(username, password string) error {
+	params := map[string]string{
+		"username": username,
+		"password": password,
+	}
+
+	result, err := s.doRequest(nullSessionID, "session", "login", params)
+	if err != nil {
+		var ubusErr *ubusError
+		if errors.As(err, &ubusErr) && ubusErr.Status == ubusStatusPermissionDenied {
+			return &dsl.AuthenticationError{Err: errors.New("login failed")}
+		}
+		return err
+	}
+
+	var data loginResult
+	err = json.Unmarshal(result, &data)
+	if err != nil {
+		return err
+	}
+
+	if data.SessionID == "" {
+		return errors.New("login failed: no session ID received")
+	}
+
+	s.sessionID = data.SessionID
+
+	return nil
+}
+
+func (s *session) call(object, method string) ([]byte, error) {
+	result, err := s.doRequest(s.sessionID, object, method, struct{}{})
+	if err != nil {
+		var ubusErr *ubusError
+		if errors.As(err, &ubusErr) && ubusErr.Status == ubusStatusNotFound {
+			return nil, errNotFound
+		}
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *session) doRequest(sessionID, object, method string, params interface{}) (json.RawMessage, error) {
+	request := jsonrpcRequest{
+		JSONRPC: "2.0",
+		ID:      s.requestCounter,
+		Method:  "call",
+		Params:  []interface{}{sessionID, object, method, params},
+	}
+	s.requestCounter++
+
+	requestJSON, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := s.post("/ubus", requestJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var response jsonrpcResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil {
+		err = fmt.Errorf("request failed: %s (%d)", response.Error.Message, response.Error.Code)
+		if response.Error.Code == jsonrpcAccessDenied {
+			// the session has most likely expired
+			return nil, &dsl.ConnectionError{Err: err}
+		}
+		return nil, err
+	}
+
+	if len(response.Result) == 0 {
+		return nil, errors.New("request failed: empty result")
+	}
+
+	var status int
+	err = json.Unmarshal(response.Result[0], &status)
+	if err != nil {
+		return nil, err
+	}
+
+	if status != ubusStatusOK {
+		return nil, &ubusError{Status: status}
+	}
+
+	if len(response.Result) < 2 {
+		return json.RawMessage("{}"), nil
+	}
+
+	return response.Result[1], nil
+}
+
+func (s *session) post(path string, data []byte) ([]byte, error) {
+	resp, err := s.client.Post(s.host+path, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("request for %s failed with status %d", path, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	return body, err
+}
+
+func (s *session) close() {
+	if s.sessionID == "" {
+		return
+	}
+
+	s.doRequest(s.sessionID, "session", "destroy", struct{}{})
+
+	s.sessionID = ""
+}
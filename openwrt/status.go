@@ -0,0 +1,219 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package openwrt
+
+import (
+	"strings"
+	"time"
+
+	"3e8.eu/go/dsl/internal/helpers"
+	"3e8.eu/go/dsl/models"
+)
+
+func interpretStatus(m *metrics) models.Status {
+	var status models.Status
+
+	status.State = interpretState(m.State, m.Up)
+	status.Mode = helpers.ParseMode(m.Mode + " " + m.Profile + " Annex " + m.Annex)
+
+	if m.Uptime != nil {
+		status.Uptime.Duration = time.Duration(*m.Uptime) * time.Second
+		status.Uptime.Valid = true
+	}
+
+	status.NearEndInventory = interpretNearEndInventory(m)
+	status.FarEndInventory = interpretFarEndInventory(&m.ATUC)
+
+	status.DownstreamActualRate.IntValue = interpretIntValue(m.Downstream.DataRate, 1000)
+	status.UpstreamActualRate.IntValue = interpretIntValue(m.Upstream.DataRate, 1000)
+
+	status.DownstreamAttainableRate.IntValue = interpretIntValue(m.Downstream.ATTNDR, 1000)
+	status.UpstreamAttainableRate.IntValue = interpretIntValue(m.Upstream.ATTNDR, 1000)
+
+	status.DownstreamMinimumErrorFreeThroughput.IntValue = interpretIntValue(m.Downstream.MinimumErrorFreeThroughput, 1000)
+	status.UpstreamMinimumErrorFreeThroughput.IntValue = interpretIntValue(m.Upstream.MinimumErrorFreeThroughput, 1000)
+
+	status.DownstreamBitswap.Enabled = interpretBoolValue(m.Downstream.Bitswap)
+	status.UpstreamBitswap.Enabled = interpretBoolValue(m.Upstream.Bitswap)
+
+	if m.Downstream.InterleaveDelay != nil {
+		status.DownstreamInterleavingDelay.FloatValue = models.FloatValue{Float: float64(*m.Downstream.InterleaveDelay) / 1000, Valid: true}
+	}
+	if m.Upstream.InterleaveDelay != nil {
+		status.UpstreamInterleavingDelay.FloatValue = models.FloatValue{Float: float64(*m.Upstream.InterleaveDelay) / 1000, Valid: true}
+	}
+
+	status.DownstreamRetransmissionEnabled = interpretBoolValue(m.Downstream.Retx)
+	status.UpstreamRetransmissionEnabled = interpretBoolValue(m.Upstream.Retx)
+
+	status.DownstreamVectoringState = interpretVectoringValue(m.Downstream.Vector)
+	status.UpstreamVectoringState = interpretVectoringValue(m.Upstream.Vector)
+
+	status.DownstreamAttenuation.FloatValue = interpretFloatValue(m.Downstream.LATN)
+	status.UpstreamAttenuation.FloatValue = interpretFloatValue(m.Upstream.LATN)
+
+	status.DownstreamSNRMargin.FloatValue = interpretFloatValue(m.Downstream.SNR)
+	status.UpstreamSNRMargin.FloatValue = interpretFloatValue(m.Upstream.SNR)
+
+	status.DownstreamPower.FloatValue = interpretFloatValue(m.Downstream.ACTATP)
+	status.UpstreamPower.FloatValue = interpretFloatValue(m.Upstream.ACTATP)
+
+	// near-end counters describe errors in the received (downstream) signal
+	near := &m.Errors.Near
+	far := &m.Errors.Far
+
+	status.UpstreamRTXTXCount = interpretIntValue(near.TxRetransmitted, 1)
+	status.DownstreamRTXTXCount = interpretIntValue(far.TxRetransmitted, 1)
+
+	status.DownstreamRTXCCount = interpretIntValue(near.RxCorrected, 1)
+	status.UpstreamRTXCCount = interpretIntValue(far.RxCorrected, 1)
+
+	status.DownstreamRTXUCCount = interpretIntValue(near.RxUncorrectedProtected, 1)
+	status.UpstreamRTXUCCount = interpretIntValue(far.RxUncorrectedProtected, 1)
+
+	status.DownstreamFECCount = interpretIntValue(near.FECC, 1)
+	status.UpstreamFECCount = interpretIntValue(far.FECC, 1)
+
+	status.DownstreamCRCCount = interpretIntValue(near.CVC, 1)
+	status.UpstreamCRCCount = interpretIntValue(far.CVC, 1)
+
+	status.DownstreamESCount = interpretIntValue(near.ES, 1)
+	status.UpstreamESCount = interpretIntValue(far.ES, 1)
+
+	status.DownstreamSESCount = interpretIntValue(near.SES, 1)
+	status.UpstreamSESCount = interpretIntValue(far.SES, 1)
+
+	status.DownstreamLOSSCount = interpretIntValue(near.LOSS, 1)
+	status.UpstreamLOSSCount = interpretIntValue(far.LOSS, 1)
+
+	status.DownstreamLOFSCount = interpretIntValue(near.LOFS, 1)
+	status.UpstreamLOFSCount = interpretIntValue(far.LOFS, 1)
+
+	status.DownstreamUASCount = interpretIntValue(near.UAS, 1)
+	status.UpstreamUASCount = interpretIntValue(far.UAS, 1)
+
+	return status
+}
+
+func interpretState(state string, up *bool) models.State {
+	str := strings.ToLower(state)
+
+	switch {
+
+	case strings.HasPrefix(str, "showtime"):
+		return models.StateShowtime
+
+	case strings.HasPrefix(str, "idle"):
+		return models.StateDownIdle
+
+	case strings.HasPrefix(str, "silent"):
+		return models.StateDownSilent
+
+	case strings.HasPrefix(str, "handshake"):
+		return models.StateInitHandshake
+
+	case strings.HasPrefix(str, "discovery"):
+		return models.StateInitChannelDiscovery
+
+	case strings.HasPrefix(str, "training"):
+		return models.StateInitTraining
+
+	case strings.HasPrefix(str, "analysis"), strings.HasPrefix(str, "exchange"):
+		return models.StateInitChannelAnalysisExchange
+
+	case strings.HasPrefix(str, "full init"):
+		return models.StateInit
+
+	case strings.HasPrefix(str, "down"), strings.HasPrefix(str, "not initialized"):
+		return models.StateDown
+
+	case strings.HasPrefix(str, "exception"):
+		return models.StateError
+
+	}
+
+	if up != nil && *up {
+		return models.StateShowtime
+	}
+
+	return models.StateUnknown
+}
+
+func interpretNearEndInventory(m *metrics) models.Inventory {
+	var inventory models.Inventory
+
+	if strings.Contains(strings.ToLower(m.Chipset), "lantiq") || strings.Contains(strings.ToLower(m.Chipset), "intel") {
+		inventory.Vendor = "Infineon"
+	} else {
+		inventory.Vendor = m.Chipset
+	}
+	inventory.Version = m.FirmwareVersion
+
+	return inventory
+}
+
+func interpretFarEndInventory(atuc *metricsATUC) models.Inventory {
+	var inventory models.Inventory
+
+	vendorID := interpretBytes(atuc.VendorID)
+	if len(vendorID) == 8 {
+		helpers.InterpretVendorID(&inventory, vendorID)
+	} else if atuc.Vendor != "" {
+		inventory.Vendor = atuc.Vendor
+	}
+
+	systemVendorID := interpretBytes(atuc.SystemVendorID)
+	if len(systemVendorID) == 8 {
+		helpers.InterpretSystemVendorID(&inventory, systemVendorID)
+	} else if atuc.SystemVendor != "" {
+		inventory.SystemVendor = helpers.FormatVendor(atuc.SystemVendor)
+	}
+
+	return inventory
+}
+
+func interpretBytes(values []int) []byte {
+	out := make([]byte, len(values))
+	for i, val := range values {
+		out[i] = byte(val)
+	}
+	return out
+}
+
+func interpretBoolValue(val *bool) (out models.BoolValue) {
+	if val != nil {
+		out.Bool = *val
+		out.Valid = true
+	}
+	return
+}
+
+func interpretIntValue(val *int64, factor int64) (out models.IntValue) {
+	if val != nil {
+		out.Int = *val / factor
+		out.Valid = true
+	}
+	return
+}
+
+func interpretFloatValue(val *float64) (out models.FloatValue) {
+	if val != nil {
+		out.Float = *val
+		out.Valid = true
+	}
+	return
+}
+
+func interpretVectoringValue(val *bool) (out models.VectoringValue) {
+	if val != nil {
+		out.Valid = true
+		if *val {
+			out.State = models.VectoringStateFull
+		} else {
+			out.State = models.VectoringStateOff
+		}
+	}
+	return
+}
@@ -0,0 +1,89 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package openwrt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"3e8.eu/go/dsl/internal/exec"
+	"3e8.eu/go/dsl/models"
+)
+
+var errNotFound = errors.New("ubus object or method not found")
+
+type caller interface {
+	call(object, method string) ([]byte, error)
+}
+
+type executorCaller struct {
+	e exec.Executor
+}
+
+func (c *executorCaller) call(object, method string) ([]byte, error) {
+	output, err := c.e.Execute("ubus call " + object + " " + method)
+	if exec.IsCommandNotFound(output, err) {
+		return nil, errors.New("ubus command not found")
+	}
+	if err != nil {
+		// ubus exits with the status code of the call, and prints the error message
+		if strings.Contains(output, "Not found") || strings.Contains(output, "Method not found") {
+			return nil, errNotFound
+		}
+		return nil, fmt.Errorf("ubus call failed: %w: %s", err, strings.TrimSpace(output))
+	}
+
+	return []byte(output), nil
+}
+
+func writeRawData(b *strings.Builder, method string, data []byte) {
+	fmt.Fprintf(b, "# ubus call dsl %s\n", method)
+	b.Write(data)
+	b.WriteString("\n\n")
+}
+
+func updateData(c caller) (status models.Status, bins models.Bins, rawData []byte, err error) {
+	var b strings.Builder
+
+	metricsData, err := c.call("dsl", "metrics")
+	if err != nil {
+		if errors.Is(err, errNotFound) {
+			err = errors.New("ubus object dsl not available, dsl_control may not be running")
+		}
+		return
+	}
+	writeRawData(&b, "metrics", metricsData)
+
+	var m metrics
+	err = json.Unmarshal(metricsData, &m)
+	if err != nil {
+		return
+	}
+
+	// spectrum data is only available in newer versions
+	statisticsData, err := c.call("dsl", "statistics")
+	if err != nil && !errors.Is(err, errNotFound) {
+		return
+	}
+	err = nil
+
+	var s statistics
+	if statisticsData != nil {
+		writeRawData(&b, "statistics", statisticsData)
+
+		err = json.Unmarshal(statisticsData, &s)
+		if err != nil {
+			return
+		}
+	}
+
+	status = interpretStatus(&m)
+	bins = interpretBins(&status, &s)
+	rawData = []byte(b.String())
+
+	return
+}
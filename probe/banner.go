@@ -0,0 +1,151 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package probe
+
+import (
+	"bufio"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ziutek/telnet"
+
+	"3e8.eu/go/dsl"
+)
+
+const bannerReadTime = 3 * time.Second
+
+type pattern struct {
+	regexp      *regexp.Regexp
+	reason      string
+	clientTypes []dsl.ClientType
+}
+
+var telnetPatterns = []pattern{
+	{
+		regexp:      regexp.MustCompile(`(?i)draytek|vigor`),
+		reason:      "telnet banner mentions DrayTek",
+		clientTypes: []dsl.ClientType{"draytek_telnet"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)bintec|be\.ip|elmeg|funkwerk`),
+		reason:      "telnet banner mentions bintec elmeg",
+		clientTypes: []dsl.ClientType{"bintecelmeg_telnet"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)openwrt`),
+		reason:      "telnet banner mentions OpenWrt",
+		clientTypes: []dsl.ClientType{"openwrt_ssh"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)busybox|openwrt`),
+		reason:      "telnet banner indicates embedded Linux",
+		clientTypes: []dsl.ClientType{"broadcom_telnet", "lantiq_telnet", "mediatek_telnet"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)lancom`),
+		reason:      "telnet banner mentions LANCOM",
+		clientTypes: []dsl.ClientType{"lancom_snmpv3", "lancom_snmp"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)zyxel|zysh`),
+		reason:      "telnet banner mentions Zyxel",
+		clientTypes: []dsl.ClientType{"zyxel_telnet", "zyxel_ssh", "zyxel_http"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)technicolor|thomson|username : `),
+		reason:      "telnet banner indicates Technicolor CLI",
+		clientTypes: []dsl.ClientType{"technicolor_telnet", "technicolor_ssh"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)fritz!box`),
+		reason:      "telnet banner mentions FRITZ!Box",
+		clientTypes: []dsl.ClientType{"fritzbox"},
+	},
+}
+
+var sshPatterns = []pattern{
+	{
+		regexp:      regexp.MustCompile(`(?i)dropbear`),
+		reason:      "SSH server is Dropbear, which is common on embedded Linux",
+		clientTypes: []dsl.ClientType{"broadcom_ssh", "lantiq_ssh", "mediatek_ssh"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)lancom`),
+		reason:      "SSH server identifies as LANCOM",
+		clientTypes: []dsl.ClientType{"lancom_snmpv3", "lancom_snmp"},
+	},
+}
+
+func clientTypesWithSuffix(suffix string) []dsl.ClientType {
+	var clientTypes []dsl.ClientType
+	for _, clientType := range dsl.GetClientTypes() {
+		if strings.HasSuffix(string(clientType), suffix) {
+			clientTypes = append(clientTypes, clientType)
+		}
+	}
+	return clientTypes
+}
+
+func matchPatterns(r *results, patterns []pattern, data string) {
+	for _, p := range patterns {
+		if p.regexp.MatchString(data) {
+			r.add(scoreBanner, p.reason, p.clientTypes...)
+		}
+	}
+}
+
+func readBanner(conn net.Conn) string {
+	conn.SetReadDeadline(time.Now().Add(bannerReadTime))
+
+	var b strings.Builder
+	buf := make([]byte, 256)
+	for b.Len() < 4096 {
+		n, err := conn.Read(buf)
+		b.Write(buf[:n])
+		if err != nil {
+			break
+		}
+
+		str := strings.TrimRight(b.String(), " ")
+		if strings.HasSuffix(str, ":") || strings.HasSuffix(str, "#") || strings.HasSuffix(str, ">") {
+			break
+		}
+	}
+
+	return b.String()
+}
+
+func probeTelnet(r *results, hostname string) {
+	conn, err := telnet.DialTimeout("tcp", net.JoinHostPort(hostname, "23"), timeout)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r.add(scoreOpenPort, "telnet port is open", clientTypesWithSuffix("_telnet")...)
+
+	banner := readBanner(conn)
+	matchPatterns(r, telnetPatterns, banner)
+}
+
+func probeSSH(r *results, hostname string) {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(hostname, "22"), timeout)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r.add(scoreOpenPort, "SSH port is open", clientTypesWithSuffix("_ssh")...)
+
+	conn.SetReadDeadline(time.Now().Add(bannerReadTime))
+	version, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil && version == "" {
+		return
+	}
+
+	matchPatterns(r, sshPatterns, version)
+}
@@ -0,0 +1,159 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package probe
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"3e8.eu/go/dsl"
+)
+
+type fingerprint struct {
+	path        string
+	regexp      *regexp.Regexp
+	reason      string
+	clientTypes []dsl.ClientType
+}
+
+var httpFingerprints = []fingerprint{
+	{
+		path:        "/login_sid.lua?version=2",
+		regexp:      regexp.MustCompile(`<SessionInfo>[\s\S]*<Challenge>`),
+		reason:      "FRITZ!Box login page found",
+		clientTypes: []dsl.ClientType{"fritzbox"},
+	},
+	{
+		path:        "/html/login/index.html",
+		regexp:      regexp.MustCompile(`challenge\s?=\s?"([0-9A-Za-z]+)"`),
+		reason:      "Speedport login page found",
+		clientTypes: []dsl.ClientType{"speedport"},
+	},
+	{
+		path:        "/js/gui-core.js",
+		regexp:      regexp.MustCompile(`GUI_ACTIVATE_SHA512ENCODE_OPT|GUI_PASSWORD_SALT`),
+		reason:      "Sagemcom XMO API found",
+		clientTypes: []dsl.ClientType{"sagemcom"},
+	},
+}
+
+var httpPatterns = []pattern{
+	{
+		regexp:      regexp.MustCompile(`(?i)fritz!box`),
+		reason:      "web interface mentions FRITZ!Box",
+		clientTypes: []dsl.ClientType{"fritzbox"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)speedport`),
+		reason:      "web interface mentions Speedport",
+		clientTypes: []dsl.ClientType{"speedport"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)sagemcom`),
+		reason:      "web interface mentions Sagemcom",
+		clientTypes: []dsl.ClientType{"sagemcom"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)draytek|vigor`),
+		reason:      "web interface mentions DrayTek",
+		clientTypes: []dsl.ClientType{"draytek_telnet"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)bintec|be\.ip|elmeg`),
+		reason:      "web interface mentions bintec elmeg",
+		clientTypes: []dsl.ClientType{"bintecelmeg_telnet"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)lancom`),
+		reason:      "web interface mentions LANCOM",
+		clientTypes: []dsl.ClientType{"lancom_snmpv3", "lancom_snmp"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)zyxel`),
+		reason:      "web interface mentions Zyxel",
+		clientTypes: []dsl.ClientType{"zyxel_http", "zyxel_ssh", "zyxel_telnet"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)technicolor|thomson`),
+		reason:      "web interface mentions Technicolor",
+		clientTypes: []dsl.ClientType{"technicolor_ssh", "technicolor_telnet"},
+	},
+	{
+		regexp:      regexp.MustCompile(`(?i)luci|openwrt`),
+		reason:      "web interface mentions OpenWrt",
+		clientTypes: []dsl.ClientType{"openwrt_http", "openwrt_ssh"},
+	},
+}
+
+func newHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// certificates of devices are usually self-signed, and only used for identification here
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+}
+
+func loadPage(client *http.Client, url string) (body []byte, ok bool) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, false
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, false
+	}
+
+	body, err = io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	return body, err == nil
+}
+
+func probeHTTPHelper(r *results, hostname, scheme, port string) {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(hostname, port), timeout)
+	if err != nil {
+		return
+	}
+	conn.Close()
+
+	client := newHTTPClient()
+	baseURL := scheme + "://" + net.JoinHostPort(hostname, port)
+
+	for _, f := range httpFingerprints {
+		body, ok := loadPage(client, baseURL+f.path)
+		if ok && f.regexp.Match(body) {
+			r.add(scoreFingerprint, f.reason+" ("+scheme+")", f.clientTypes...)
+		}
+	}
+
+	body, ok := loadPage(client, baseURL+"/")
+	if ok {
+		matchPatterns(r, httpPatterns, string(body))
+	}
+}
+
+func probeTR064(r *results, hostname string) {
+	client := newHTTPClient()
+
+	body, ok := loadPage(client, "http://"+net.JoinHostPort(hostname, "49000")+"/tr64desc.xml")
+	if !ok || !strings.Contains(string(body), "WANDSLInterfaceConfig") {
+		return
+	}
+
+	r.add(scoreFingerprint, "TR-064 with DSL interface found", "tr064")
+}
+
+func probeHTTP(r *results, hostname string) {
+	probeHTTPHelper(r, hostname, "http", "80")
+}
+
+func probeHTTPS(r *results, hostname string) {
+	probeHTTPHelper(r, hostname, "https", "443")
+}
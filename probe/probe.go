@@ -0,0 +1,111 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package probe
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/helpers"
+)
+
+const (
+	scoreOpenPort    = 1
+	scoreBanner      = 3
+	scoreFingerprint = 10
+)
+
+const timeout = 5 * time.Second
+
+// Result describes a client type that is likely to be suitable for the probed device.
+type Result struct {
+	Type    dsl.ClientType
+	Score   int
+	Reasons []string
+}
+
+type results struct {
+	mutex sync.Mutex
+	items map[dsl.ClientType]*Result
+}
+
+func (r *results) add(score int, reason string, clientTypes ...dsl.ClientType) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, clientType := range clientTypes {
+		// only client types which have been registered are considered
+		if !clientType.IsValid() {
+			continue
+		}
+
+		item, ok := r.items[clientType]
+		if !ok {
+			item = &Result{Type: clientType}
+			r.items[clientType] = item
+		}
+
+		item.Score += score
+		item.Reasons = append(item.Reasons, reason)
+	}
+}
+
+func (r *results) list() []Result {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	list := make([]Result, 0, len(r.items))
+	for _, item := range r.items {
+		list = append(list, *item)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Score != list[j].Score {
+			return list[i].Score > list[j].Score
+		}
+		return list[i].Type < list[j].Type
+	})
+
+	return list
+}
+
+// Probe checks which services are available on the given host, and tries to identify the device
+// based on login pages, banners and prompts. It returns a list of client types ordered by likelihood,
+// which is empty if the device could not be identified.
+func Probe(host string) ([]Result, error) {
+	hostname, _, err := helpers.SplitHostPort(host)
+	if err != nil {
+		return nil, err
+	}
+	if hostname == "" {
+		return nil, errors.New("invalid host")
+	}
+
+	r := results{items: make(map[dsl.ClientType]*Result)}
+
+	checks := []func(r *results, hostname string){
+		probeTelnet,
+		probeSSH,
+		probeHTTP,
+		probeHTTPS,
+		probeTR064,
+		probeSNMP,
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(checks))
+	for _, check := range checks {
+		go func(check func(r *results, hostname string)) {
+			defer wg.Done()
+			check(&r, hostname)
+		}(check)
+	}
+	wg.Wait()
+
+	return r.list(), nil
+}
@@ -0,0 +1,69 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package probe
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+
+	"3e8.eu/go/dsl"
+)
+
+const oidSysDescr = "1.3.6.1.2.1.1.1.0"
+
+var snmpEnterprises = map[uint32]struct {
+	reason      string
+	clientTypes []dsl.ClientType
+}{
+	2356: {
+		reason:      "SNMP engine ID belongs to LANCOM",
+		clientTypes: []dsl.ClientType{"lancom_snmpv3", "lancom_snmp"},
+	},
+}
+
+func probeSNMP(r *results, hostname string) {
+	// The SNMPv3 engine discovery is answered by agents without any credentials. The engine ID
+	// usually contains the private enterprise number of the vendor (RFC 3411).
+	client := &gosnmp.GoSNMP{
+		Target:        hostname,
+		Port:          161,
+		Transport:     "udp",
+		Version:       gosnmp.Version3,
+		SecurityModel: gosnmp.UserSecurityModel,
+		MsgFlags:      gosnmp.NoAuthNoPriv,
+		SecurityParameters: &gosnmp.UsmSecurityParameters{
+			UserName: "probe",
+		},
+		Timeout: 2 * time.Second,
+		Retries: 1,
+	}
+
+	err := client.Connect()
+	if err != nil {
+		return
+	}
+	defer client.Conn.Close()
+
+	// the request itself is expected to fail due to the unknown user
+	client.Get([]string{oidSysDescr})
+
+	engineID := []byte(client.ContextEngineID)
+	if len(engineID) < 5 {
+		return
+	}
+
+	r.add(scoreOpenPort, "SNMPv3 agent responds", "lancom_snmpv3", "snmpmib")
+
+	if engineID[0]&0x80 == 0 {
+		return
+	}
+
+	enterprise := binary.BigEndian.Uint32(engineID[0:4]) &^ 0x80000000
+	if item, ok := snmpEnterprises[enterprise]; ok {
+		r.add(scoreFingerprint, item.reason, item.clientTypes...)
+	}
+}
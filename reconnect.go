@@ -0,0 +1,138 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package dsl
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"3e8.eu/go/dsl/models"
+)
+
+// ConnectionStats contains the number of automatic reconnects of a client.
+type ConnectionStats struct {
+	Reconnects       int
+	FailedReconnects int
+	LastReconnect    time.Time
+}
+
+// reconnectClient wraps the client of a backend, and transparently creates a new one if the session
+// is lost (as indicated by a ConnectionError). The data of the last successful update is retained.
+//
+// This is the only layer which retries after connection errors, so users of NewClient should keep using
+// the client instead of creating a new one. Any state detected by the backend on connecting (such as the
+// supported commands) is detected again by the new client. A failed reconnect is returned as
+// ConnectionError, and is retried on the next update.
+type reconnectClient struct {
+	newFunc func(config Config) (Client, error)
+	config  Config
+	client  Client
+
+	rawData []byte
+	status  models.Status
+	bins    models.Bins
+	lines   []models.Line
+
+	stats ConnectionStats
+}
+
+func newReconnectClient(newFunc func(config Config) (Client, error), config Config) (*reconnectClient, error) {
+	client, err := newFunc(config)
+	if err != nil {
+		return nil, err
+	}
+
+	c := reconnectClient{
+		newFunc: newFunc,
+		config:  config,
+		client:  client,
+	}
+
+	return &c, nil
+}
+
+func (c *reconnectClient) RawData() []byte {
+	return c.rawData
+}
+
+func (c *reconnectClient) Status() models.Status {
+	return c.status
+}
+
+func (c *reconnectClient) Bins() models.Bins {
+	return c.bins
+}
+
+func (c *reconnectClient) Lines() []models.Line {
+	return c.lines
+}
+
+func (c *reconnectClient) reconnect() error {
+	if c.client != nil {
+		c.client.Close()
+		c.client = nil
+	}
+
+	client, err := c.newFunc(c.config)
+	if err != nil {
+		c.stats.FailedReconnects++
+		return &ConnectionError{Err: fmt.Errorf("reconnect failed: %w", err)}
+	}
+
+	c.client = client
+	c.stats.Reconnects++
+	c.stats.LastReconnect = time.Now()
+
+	return nil
+}
+
+func (c *reconnectClient) UpdateData() error {
+	if c.client == nil {
+		err := c.reconnect()
+		if err != nil {
+			return err
+		}
+	}
+
+	err := c.client.UpdateData()
+
+	var connErr *ConnectionError
+	if errors.As(err, &connErr) {
+		err = c.reconnect()
+		if err != nil {
+			return err
+		}
+
+		err = c.client.UpdateData()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	c.rawData = c.client.RawData()
+	c.status = c.client.Status()
+	c.bins = c.client.Bins()
+	c.lines = GetLines(c.client)
+
+	return nil
+}
+
+func (c *reconnectClient) Close() {
+	if c.client != nil {
+		c.client.Close()
+		c.client = nil
+	}
+}
+
+// GetConnectionStats returns statistics about the connection handling of a client returned by
+// NewClient, such as the number of automatic reconnects.
+func GetConnectionStats(client Client) ConnectionStats {
+	if c, ok := client.(*reconnectClient); ok {
+		return c.stats
+	}
+	return ConnectionStats{}
+}
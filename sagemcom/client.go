@@ -5,15 +5,14 @@
 package sagemcom
 
 import (
-	"encoding/json"
-	"fmt"
-
 	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/tr181"
+	"3e8.eu/go/dsl/internal/xmo"
 	"3e8.eu/go/dsl/models"
 )
 
 type client struct {
-	session *session
+	session *xmo.Session
 	rawData []byte
 	status  models.Status
 	bins    models.Bins
@@ -29,7 +28,7 @@ func NewClient(config Config) (dsl.Client, error) {
 		user = "admin"
 	}
 
-	c.session, err = newSession(config.Host, user, config.Password, config.TLSSkipVerify)
+	c.session, err = xmo.NewSession(config.Host, user, config.Password, config.TLSSkipVerify)
 	if err != nil {
 		return nil, err
 	}
@@ -50,28 +49,28 @@ func (c *client) Bins() models.Bins {
 }
 
 func (c *client) UpdateData() (err error) {
-	c.rawData, err = c.session.loadValue("Device/DSL")
+	c.rawData, err = c.session.LoadValue("Device/DSL")
 	if err != nil {
 		return
 	}
 
-	var data dslWrapper
-	err = json.Unmarshal(c.rawData, &data)
+	data, err := tr181.ParseJSON(c.rawData)
 	if err != nil {
 		return
 	}
 
-	if len(data.DSL.Lines) != 1 || len(data.DSL.Channels) != 1 {
-		return fmt.Errorf("unexpected number of lines (%d) or channels (%d)",
-			len(data.DSL.Lines), len(data.DSL.Channels))
+	c.status, c.bins, err = tr181.Interpret(data)
+	if err != nil {
+		return
 	}
 
-	c.status = interpretStatus(&data.DSL)
-	c.bins = interpretBins(&c.status, &data.DSL)
+	if c.status.NearEndInventory.Vendor == "" {
+		c.status.NearEndInventory.Vendor = "Sagemcom"
+	}
 
 	return
 }
 
 func (c *client) Close() {
-	c.session.close()
+	c.session.Close()
 }
@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package snmpmib
+
+import (
+	"strings"
+
+	"3e8.eu/go/dsl/internal/helpers"
+	"3e8.eu/go/dsl/internal/snmp"
+	"3e8.eu/go/dsl/models"
+)
+
+func parseBins(status *models.Status, values snmp.Values, lineIndex string) models.Bins {
+	var bins models.Bins
+
+	bins.Mode = status.Mode
+
+	line := "." + lineIndex
+
+	for _, dir := range []struct {
+		direction string
+		bits      *models.BinsBits
+		snr       *models.BinsFloat
+		qln       *models.BinsFloat
+		hlog      *models.BinsFloat
+	}{
+		{xdsl2DirectionDownstream, &bins.Bits.Downstream, &bins.SNR.Downstream, &bins.QLN.Downstream, &bins.Hlog.Downstream},
+		{xdsl2DirectionUpstream, &bins.Bits.Upstream, &bins.SNR.Upstream, &bins.QLN.Upstream, &bins.Hlog.Upstream},
+	} {
+		bitsData := getSegmentData(values, xdsl2SCStatusSegmentEntry+oidXdsl2SCStatusSegmentBitsAlloc+line+dir.direction)
+		*dir.bits = interpretBitsData(bitsData)
+
+		snrData := getSegmentData(values, xdsl2SCStatusSegmentEntry+oidXdsl2SCStatusSegmentSnr+line+dir.direction)
+		snrGroupSize := getGroupSize(values, xdsl2SCStatusEntry+oidXdsl2SCStatusSnrScGroupSize+line+dir.direction)
+		*dir.snr = interpretSNRData(snrData, snrGroupSize)
+
+		qlnData := getSegmentData(values, xdsl2SCStatusSegmentEntry+oidXdsl2SCStatusSegmentQuiet+line+dir.direction)
+		qlnGroupSize := getGroupSize(values, xdsl2SCStatusEntry+oidXdsl2SCStatusQlnScGroupSize+line+dir.direction)
+		*dir.qln = interpretQLNData(qlnData, qlnGroupSize)
+
+		hlogData := getSegmentData(values, xdsl2SCStatusSegmentEntry+oidXdsl2SCStatusSegmentLog+line+dir.direction)
+		hlogGroupSize := getGroupSize(values, xdsl2SCStatusEntry+oidXdsl2SCStatusLogScGroupSize+line+dir.direction)
+		*dir.hlog = interpretHlogData(hlogData, hlogGroupSize)
+	}
+
+	helpers.GenerateBandsData(&bins)
+
+	return bins
+}
+
+// The per-subcarrier data is split into multiple segments, which are returned in order by the walk.
+func getSegmentData(values snmp.Values, oid string) []byte {
+	var data []byte
+
+	values.Walk(func(val snmp.Value) {
+		if !strings.HasPrefix(val.OID, oid+".") {
+			return
+		}
+		if segment, err := val.ValBytes(); err == nil {
+			data = append(data, segment...)
+		}
+	})
+
+	return data
+}
+
+func getGroupSize(values snmp.Values, oid string) int {
+	if groupSize, err := values.GetInt64(oid); err == nil && groupSize > 0 {
+		return int(groupSize)
+	}
+	return 1
+}
+
+func interpretBitsData(data []byte) (out models.BinsBits) {
+	if len(data) == 0 {
+		return
+	}
+
+	out.Data = make([]int8, 2*len(data))
+	for i, b := range data {
+		out.Data[2*i] = int8(b >> 4)
+		out.Data[2*i+1] = int8(b & 0xf)
+	}
+
+	return
+}
+
+func interpretSNRData(data []byte, groupSize int) (out models.BinsFloat) {
+	if len(data) == 0 {
+		return
+	}
+
+	out.GroupSize = groupSize
+	out.Data = make([]float64, len(data))
+	for i, b := range data {
+		if b == 255 {
+			out.Data[i] = -32.5
+		} else {
+			out.Data[i] = -32 + float64(b)/2
+		}
+	}
+
+	return
+}
+
+func interpretQLNData(data []byte, groupSize int) (out models.BinsFloat) {
+	if len(data) == 0 {
+		return
+	}
+
+	out.GroupSize = groupSize
+	out.Data = make([]float64, len(data))
+	for i, b := range data {
+		if b == 255 {
+			out.Data[i] = -150.5
+		} else {
+			out.Data[i] = -23 - float64(b)/2
+		}
+	}
+
+	return
+}
+
+func interpretHlogData(data []byte, groupSize int) (out models.BinsFloat) {
+	if len(data) < 2 {
+		return
+	}
+
+	out.GroupSize = groupSize
+	out.Data = make([]float64, len(data)/2)
+	for i := range out.Data {
+		val := (uint16(data[2*i])<<8 | uint16(data[2*i+1])) & 0x3ff
+		if val == 1023 {
+			out.Data[i] = -96.3
+		} else {
+			out.Data[i] = 6 - float64(val)/10
+		}
+	}
+
+	return
+}
@@ -0,0 +1,204 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package snmpmib
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/snmp"
+	"3e8.eu/go/dsl/models"
+)
+
+type client struct {
+	client       *snmp.Client
+	lineIndex    string
+	channelIndex string
+	rawData      []byte
+	status       models.Status
+	bins         models.Bins
+}
+
+func NewClient(config Config) (dsl.Client, error) {
+	c := client{}
+
+	var err error
+
+	version := snmp.Version(config.Version)
+	if version == "" {
+		version = snmp.Version2c
+	}
+
+	if version == snmp.Version3 {
+		c.client, err = snmp.NewClient(config.Host, "udp", config.User,
+			snmp.AuthProtocol(config.AuthProtocol), snmp.PrivacyProtocol(config.PrivacyProtocol),
+			config.Password, config.EncryptionPassphrase)
+	} else {
+		c.client, err = snmp.NewClientCommunity(config.Host, "udp", version, config.Community)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.detectInterfaces(config.Interface)
+	if err != nil {
+		c.client.Close()
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (c *client) detectInterfaces(ifIndex string) error {
+	ifTypes, err := c.client.Walk(oidIfType)
+	if err != nil {
+		return err
+	}
+
+	if ifIndex != "" {
+		if _, err := strconv.ParseUint(ifIndex, 10, 32); err != nil {
+			return errors.New("invalid interface index")
+		}
+		if ifTypes.Get(oidIfType+"."+ifIndex) == nil {
+			return errors.New("interface not found")
+		}
+		c.lineIndex = ifIndex
+	} else {
+		ifTypes.Walk(func(val snmp.Value) {
+			if c.lineIndex != "" {
+				return
+			}
+			if ifType, err := val.ValInt64(); err == nil {
+				if ifType == ifTypeADSL || ifType == ifTypeVDSL || ifType == ifTypeVDSL2 {
+					c.lineIndex = strings.TrimPrefix(val.OID, oidIfType+".")
+				}
+			}
+		})
+		if c.lineIndex == "" {
+			return errors.New("no DSL interface found")
+		}
+	}
+
+	// Channel tables may be indexed by the interface stacked on top of the line. The first
+	// interface stacked on top is used, and the line interface itself as a fallback.
+	c.channelIndex = c.lineIndex
+
+	ifStack, err := c.client.Walk(oidIfStackTable)
+	if err != nil {
+		return nil
+	}
+
+	ifStack.Walk(func(val snmp.Value) {
+		if c.channelIndex != c.lineIndex {
+			return
+		}
+		indexes := strings.Split(strings.TrimPrefix(val.OID, oidIfStackTable+"."), ".")
+		if len(indexes) == 2 && indexes[1] == c.lineIndex && indexes[0] != "0" {
+			c.channelIndex = indexes[0]
+		}
+	})
+
+	return nil
+}
+
+func (c *client) RawData() []byte {
+	return c.rawData
+}
+
+func (c *client) Status() models.Status {
+	return c.status
+}
+
+func (c *client) Bins() models.Bins {
+	return c.bins
+}
+
+func (c *client) getOIDs() []string {
+	line := "." + c.lineIndex
+	channel := "." + c.channelIndex
+
+	return []string{
+		oidSysUpTime,
+		oidIfOperStatus + line,
+		oidIfLastChange + line,
+
+		adslAtucPhys + oidAdslPhysInvSerialNumber + line,
+		adslAtucPhys + oidAdslPhysInvVendorID + line,
+		adslAtucPhys + oidAdslPhysInvVersionNumber + line,
+		adslAtucPhys + oidAdslPhysCurrSnrMgn + line,
+		adslAtucPhys + oidAdslPhysCurrAtn + line,
+		adslAtucPhys + oidAdslPhysCurrOutputPwr + line,
+		adslAtucPhys + oidAdslPhysCurrAttainRate + line,
+		adslAturPhys + oidAdslPhysInvSerialNumber + line,
+		adslAturPhys + oidAdslPhysInvVendorID + line,
+		adslAturPhys + oidAdslPhysInvVersionNumber + line,
+		adslAturPhys + oidAdslPhysCurrSnrMgn + line,
+		adslAturPhys + oidAdslPhysCurrAtn + line,
+		adslAturPhys + oidAdslPhysCurrOutputPwr + line,
+		adslAturPhys + oidAdslPhysCurrAttainRate + line,
+
+		adslAtucChan + oidAdslChanInterleaveDelay + channel,
+		adslAtucChan + oidAdslChanCurrTxRate + channel,
+		adslAturChan + oidAdslChanInterleaveDelay + channel,
+		adslAturChan + oidAdslChanCurrTxRate + channel,
+
+		adslAtucPerfData + oidAdslAtucPerfESs + line,
+		adslAturPerfData + oidAdslAturPerfESs + line,
+
+		adslAtucChanPerfData + oidAdslChanCorrectedBlks + channel,
+		adslAtucChanPerfData + oidAdslChanUncorrectBlks + channel,
+		adslAturChanPerfData + oidAdslChanCorrectedBlks + channel,
+		adslAturChanPerfData + oidAdslChanUncorrectBlks + channel,
+
+		xdsl2LineEntry + oidXdsl2LineStatusXtuTransSys + line,
+		xdsl2LineEntry + oidXdsl2LineStatusAttainableRateDs + line,
+		xdsl2LineEntry + oidXdsl2LineStatusAttainableRateUs + line,
+		xdsl2LineEntry + oidXdsl2LineStatusActAtpDs + line,
+		xdsl2LineEntry + oidXdsl2LineStatusActAtpUs + line,
+		xdsl2LineEntry + oidXdsl2LineStatusActProfile + line,
+
+		xdsl2LineBandEntry + oidXdsl2LineBandStatusLnAtten + line,
+		xdsl2LineBandEntry + oidXdsl2LineBandStatusSigAtten + line,
+		xdsl2LineBandEntry + oidXdsl2LineBandStatusSnrMargin + line,
+
+		xdsl2ChStatusEntry + oidXdsl2ChStatusActDataRate + channel,
+		xdsl2ChStatusEntry + oidXdsl2ChStatusActDelay + channel,
+		xdsl2ChStatusEntry + oidXdsl2ChStatusActInp + channel,
+
+		xdsl2SCStatusEntry + oidXdsl2SCStatusLogScGroupSize + line,
+		xdsl2SCStatusEntry + oidXdsl2SCStatusQlnScGroupSize + line,
+		xdsl2SCStatusEntry + oidXdsl2SCStatusSnrScGroupSize + line,
+
+		xdsl2SCStatusSegmentEntry + oidXdsl2SCStatusSegmentLog + line,
+		xdsl2SCStatusSegmentEntry + oidXdsl2SCStatusSegmentQuiet + line,
+		xdsl2SCStatusSegmentEntry + oidXdsl2SCStatusSegmentSnr + line,
+		xdsl2SCStatusSegmentEntry + oidXdsl2SCStatusSegmentBitsAlloc + line,
+
+		xdsl2LineInventoryEntry + oidXdsl2LInvG994VendorId + line,
+		xdsl2LineInventoryEntry + oidXdsl2LInvSystemVendorId + line,
+		xdsl2LineInventoryEntry + oidXdsl2LInvVersionNumber + line,
+		xdsl2LineInventoryEntry + oidXdsl2LInvSerialNumber + line,
+		xdsl2LineInventoryEntry + oidXdsl2LInvSelfTestResult + line,
+	}
+}
+
+func (c *client) UpdateData() (err error) {
+	values, err := c.client.WalkMultiple(c.getOIDs())
+	if err != nil {
+		return
+	}
+
+	c.status = parseStatus(values, c.lineIndex, c.channelIndex)
+	c.bins = parseBins(&c.status, values, c.lineIndex)
+	c.rawData = []byte(values.String())
+
+	return
+}
+
+func (c *client) Close() {
+	c.client.Close()
+}
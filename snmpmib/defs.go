@@ -0,0 +1,121 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package snmpmib
+
+// SNMPv2-MIB, IF-MIB (RFC 2863)
+const (
+	oidSysUpTime = ".1.3.6.1.2.1.1.3.0" // TimeTicks
+
+	oidIfType       = ".1.3.6.1.2.1.2.2.1.3"    // Integer -> ifType
+	oidIfOperStatus = ".1.3.6.1.2.1.2.2.1.8"    // Integer -> ifOperStatus
+	oidIfLastChange = ".1.3.6.1.2.1.2.2.1.9"    // TimeTicks
+	oidIfStackTable = ".1.3.6.1.2.1.31.1.2.1.3" // Integer, indexed by higher and lower layer
+)
+
+const (
+	ifTypeADSL  = 94
+	ifTypeVDSL  = 97
+	ifTypeVDSL2 = 251
+)
+
+const (
+	ifOperStatusUp             = 1
+	ifOperStatusDown           = 2
+	ifOperStatusDormant        = 5
+	ifOperStatusLowerLayerDown = 7
+)
+
+// ADSL-LINE-MIB (RFC 2662)
+const (
+	adslLineMib = ".1.3.6.1.2.1.10.94.1.1"
+
+	adslAtucPhys = adslLineMib + ".2.1"
+	adslAturPhys = adslLineMib + ".3.1"
+
+	oidAdslPhysInvSerialNumber  = ".1" // OctetString
+	oidAdslPhysInvVendorID      = ".2" // OctetString
+	oidAdslPhysInvVersionNumber = ".3" // OctetString
+	oidAdslPhysCurrSnrMgn       = ".4" // Integer, 0.1 dB
+	oidAdslPhysCurrAtn          = ".5" // Gauge32, 0.1 dB
+	oidAdslPhysCurrOutputPwr    = ".7" // Integer, 0.1 dBm
+	oidAdslPhysCurrAttainRate   = ".8" // Gauge32, bit/s
+
+	adslAtucChan = adslLineMib + ".4.1"
+	adslAturChan = adslLineMib + ".5.1"
+
+	oidAdslChanInterleaveDelay = ".1" // Gauge32, ms
+	oidAdslChanCurrTxRate      = ".2" // Gauge32, bit/s
+
+	adslAtucPerfData = adslLineMib + ".6.1"
+	adslAturPerfData = adslLineMib + ".7.1"
+
+	oidAdslAtucPerfESs = ".5" // Counter32
+	oidAdslAturPerfESs = ".4" // Counter32
+
+	adslAtucChanPerfData = adslLineMib + ".10.1"
+	adslAturChanPerfData = adslLineMib + ".11.1"
+
+	oidAdslChanCorrectedBlks = ".3" // Counter32
+	oidAdslChanUncorrectBlks = ".4" // Counter32
+)
+
+// VDSL2-LINE-MIB (RFC 5650)
+const (
+	xdsl2Objects = ".1.3.6.1.2.1.10.251.1"
+
+	xdsl2LineEntry = xdsl2Objects + ".1.1.1"
+
+	oidXdsl2LineStatusXtuTransSys      = ".13" // OctetString -> Xdsl2TransmissionModeType
+	oidXdsl2LineStatusAttainableRateDs = ".20" // Unsigned32, bit/s
+	oidXdsl2LineStatusAttainableRateUs = ".21" // Unsigned32, bit/s
+	oidXdsl2LineStatusActAtpDs         = ".24" // Integer, 0.1 dBm
+	oidXdsl2LineStatusActAtpUs         = ".25" // Integer, 0.1 dBm
+	oidXdsl2LineStatusActProfile       = ".26" // OctetString -> Xdsl2LineProfiles
+
+	xdsl2LineBandEntry = xdsl2Objects + ".1.3.1"
+
+	oidXdsl2LineBandStatusLnAtten   = ".2" // Unsigned32, 0.1 dB, indexed by band
+	oidXdsl2LineBandStatusSigAtten  = ".3" // Unsigned32, 0.1 dB, indexed by band
+	oidXdsl2LineBandStatusSnrMargin = ".4" // Integer, 0.1 dB, indexed by band
+
+	xdsl2ChStatusEntry = xdsl2Objects + ".2.2.1"
+
+	oidXdsl2ChStatusActDataRate = ".2" // Unsigned32, bit/s, indexed by unit
+	oidXdsl2ChStatusActDelay    = ".4" // Unsigned32, ms, indexed by unit
+	oidXdsl2ChStatusActInp      = ".5" // Unsigned32, 0.1 symbols, indexed by unit
+
+	xdsl2SCStatusEntry = xdsl2Objects + ".2.3.1"
+
+	oidXdsl2SCStatusLogScGroupSize = ".5" // Unsigned32, indexed by direction
+	oidXdsl2SCStatusQlnScGroupSize = ".7" // Unsigned32, indexed by direction
+	oidXdsl2SCStatusSnrScGroupSize = ".9" // Unsigned32, indexed by direction
+
+	xdsl2SCStatusSegmentEntry = xdsl2Objects + ".2.5.1"
+
+	oidXdsl2SCStatusSegmentLog       = ".4" // OctetString, indexed by direction and segment
+	oidXdsl2SCStatusSegmentQuiet     = ".5" // OctetString, indexed by direction and segment
+	oidXdsl2SCStatusSegmentSnr       = ".6" // OctetString, indexed by direction and segment
+	oidXdsl2SCStatusSegmentBitsAlloc = ".7" // OctetString, indexed by direction and segment
+
+	xdsl2LineInventoryEntry = xdsl2Objects + ".3.1.1"
+
+	oidXdsl2LInvG994VendorId   = ".2" // OctetString, indexed by unit
+	oidXdsl2LInvSystemVendorId = ".3" // OctetString, indexed by unit
+	oidXdsl2LInvVersionNumber  = ".4" // OctetString, indexed by unit
+	oidXdsl2LInvSerialNumber   = ".5" // OctetString, indexed by unit
+	oidXdsl2LInvSelfTestResult = ".6" // Unsigned32, indexed by unit
+)
+
+const (
+	xdsl2UnitXtuc = ".1"
+	xdsl2UnitXtur = ".2"
+
+	xdsl2DirectionUpstream   = ".1"
+	xdsl2DirectionDownstream = ".2"
+
+	// Xdsl2Band according to RFC 5650: upstream(1), downstream(2), us0(3), ds1(4), ...
+	xdsl2BandUpstream   = ".1"
+	xdsl2BandDownstream = ".2"
+)
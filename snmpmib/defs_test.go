@@ -0,0 +1,45 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package snmpmib
+
+import (
+	"testing"
+)
+
+func TestOIDSuffixes(t *testing.T) {
+	// xdsl2LineBandTable is indexed by ifIndex and Xdsl2Band, see RFC 5650
+	line := ".7"
+
+	tests := []struct {
+		name     string
+		oid      string
+		expected string
+	}{
+		{"band upstream", xdsl2BandUpstream, ".1"},
+		{"band downstream", xdsl2BandDownstream, ".2"},
+		{"direction upstream", xdsl2DirectionUpstream, ".1"},
+		{"direction downstream", xdsl2DirectionDownstream, ".2"},
+		{"unit xtuc", xdsl2UnitXtuc, ".1"},
+		{"unit xtur", xdsl2UnitXtur, ".2"},
+		{"SNR margin upstream",
+			xdsl2LineBandEntry + oidXdsl2LineBandStatusSnrMargin + line + xdsl2BandUpstream,
+			".1.3.6.1.2.1.10.251.1.1.3.1.4.7.1"},
+		{"SNR margin downstream",
+			xdsl2LineBandEntry + oidXdsl2LineBandStatusSnrMargin + line + xdsl2BandDownstream,
+			".1.3.6.1.2.1.10.251.1.1.3.1.4.7.2"},
+		{"attenuation upstream",
+			xdsl2LineBandEntry + oidXdsl2LineBandStatusLnAtten + line + xdsl2BandUpstream,
+			".1.3.6.1.2.1.10.251.1.1.3.1.2.7.1"},
+		{"attenuation downstream",
+			xdsl2LineBandEntry + oidXdsl2LineBandStatusLnAtten + line + xdsl2BandDownstream,
+			".1.3.6.1.2.1.10.251.1.1.3.1.2.7.2"},
+	}
+
+	for _, test := range tests {
+		if test.oid != test.expected {
+			t.Errorf("%s: got %s, expected %s", test.name, test.oid, test.expected)
+		}
+	}
+}
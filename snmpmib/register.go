@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package snmpmib
+
+import (
+	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/snmp"
+)
+
+func init() {
+	newFunc := func(config dsl.Config) (dsl.Client, error) {
+		clientConfig := Config{
+			Host:                 config.Host,
+			User:                 config.User,
+			Password:             config.AuthPassword,
+			EncryptionPassphrase: config.EncryptionPassphrase,
+			Version:              config.Options["Version"],
+			Community:            config.Options["Community"],
+			AuthProtocol:         config.Options["AuthProtocol"],
+			PrivacyProtocol:      config.Options["PrivacyProtocol"],
+			Interface:            config.Options["Interface"],
+		}
+		return NewClient(clientConfig)
+	}
+	clientDesc := dsl.ClientDesc{
+		Title:                        "SNMP (ADSL-LINE-MIB / VDSL2-LINE-MIB)",
+		RequiresUser:                 dsl.TristateMaybe,
+		SupportedAuthTypes:           dsl.AuthTypePassword,
+		SupportsEncryptionPassphrase: true,
+		Options: map[string]dsl.Option{
+			"Version": snmp.GetVersionOption(
+				snmp.Version2c,
+				snmp.Version1,
+				snmp.Version3,
+			),
+			"Community": dsl.Option{
+				Description: "community string for SNMPv1/v2c (default: " + snmp.DefaultCommunity + ")",
+				Type:        dsl.OptionTypeString,
+			},
+			"AuthProtocol": snmp.GetAuthProtocolOption(
+				snmp.AuthProtocolNone,
+				snmp.AuthProtocolMD5,
+				snmp.AuthProtocolSHA,
+				snmp.AuthProtocolSHA224,
+				snmp.AuthProtocolSHA256,
+				snmp.AuthProtocolSHA384,
+				snmp.AuthProtocolSHA512,
+			),
+			"PrivacyProtocol": snmp.GetPrivacyProtocolOption(
+				snmp.PrivacyProtocolNone,
+				snmp.PrivacyProtocolDES,
+				snmp.PrivacyProtocolAES128,
+				snmp.PrivacyProtocolAES192,
+				snmp.PrivacyProtocolAES192C,
+				snmp.PrivacyProtocolAES256,
+				snmp.PrivacyProtocolAES256C,
+			),
+			"Interface": dsl.Option{
+				Description: "interface index (ifIndex) of the DSL line, the first DSL interface is used if not set",
+				Type:        dsl.OptionTypeString,
+			},
+		},
+	}
+	dsl.RegisterClient("snmpmib", newFunc, clientDesc)
+}
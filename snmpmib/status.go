@@ -0,0 +1,324 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package snmpmib
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"3e8.eu/go/dsl/internal/helpers"
+	"3e8.eu/go/dsl/internal/snmp"
+	"3e8.eu/go/dsl/models"
+)
+
+type modeBit struct {
+	Type    models.ModeType
+	Subtype models.ModeSubtype
+}
+
+// bit positions of Xdsl2TransmissionModeType, matching the XTSE octets of G.997.1
+var transmissionModeBits = map[int]modeBit{
+	0:  {models.ModeTypeADSL, models.ModeSubtypeUnknown},
+	1:  {models.ModeTypeADSL, models.ModeSubtypeUnknown},
+	2:  {models.ModeTypeADSL, models.ModeSubtypeAnnexA},
+	3:  {models.ModeTypeADSL, models.ModeSubtypeAnnexA},
+	4:  {models.ModeTypeADSL, models.ModeSubtypeAnnexB},
+	5:  {models.ModeTypeADSL, models.ModeSubtypeAnnexB},
+	18: {models.ModeTypeADSL2, models.ModeSubtypeAnnexA},
+	19: {models.ModeTypeADSL2, models.ModeSubtypeAnnexA},
+	20: {models.ModeTypeADSL2, models.ModeSubtypeAnnexB},
+	21: {models.ModeTypeADSL2, models.ModeSubtypeAnnexB},
+	28: {models.ModeTypeADSL2, models.ModeSubtypeAnnexI},
+	29: {models.ModeTypeADSL2, models.ModeSubtypeAnnexI},
+	30: {models.ModeTypeADSL2, models.ModeSubtypeAnnexJ},
+	31: {models.ModeTypeADSL2, models.ModeSubtypeAnnexJ},
+	34: {models.ModeTypeADSL2, models.ModeSubtypeAnnexL},
+	35: {models.ModeTypeADSL2, models.ModeSubtypeAnnexL},
+	36: {models.ModeTypeADSL2, models.ModeSubtypeAnnexL},
+	37: {models.ModeTypeADSL2, models.ModeSubtypeAnnexL},
+	38: {models.ModeTypeADSL2, models.ModeSubtypeAnnexM},
+	39: {models.ModeTypeADSL2, models.ModeSubtypeAnnexM},
+	40: {models.ModeTypeADSL2Plus, models.ModeSubtypeAnnexA},
+	41: {models.ModeTypeADSL2Plus, models.ModeSubtypeAnnexA},
+	42: {models.ModeTypeADSL2Plus, models.ModeSubtypeAnnexB},
+	43: {models.ModeTypeADSL2Plus, models.ModeSubtypeAnnexB},
+	46: {models.ModeTypeADSL2Plus, models.ModeSubtypeAnnexI},
+	47: {models.ModeTypeADSL2Plus, models.ModeSubtypeAnnexI},
+	48: {models.ModeTypeADSL2Plus, models.ModeSubtypeAnnexJ},
+	49: {models.ModeTypeADSL2Plus, models.ModeSubtypeAnnexJ},
+	50: {models.ModeTypeADSL2Plus, models.ModeSubtypeAnnexM},
+	51: {models.ModeTypeADSL2Plus, models.ModeSubtypeAnnexM},
+	56: {models.ModeTypeVDSL2, models.ModeSubtypeUnknown},
+	57: {models.ModeTypeVDSL2, models.ModeSubtypeUnknown},
+	58: {models.ModeTypeVDSL2, models.ModeSubtypeUnknown},
+}
+
+// bit positions of Xdsl2LineProfiles
+var profileBits = []models.ModeSubtype{
+	models.ModeSubtypeProfile8a,
+	models.ModeSubtypeProfile8b,
+	models.ModeSubtypeProfile8c,
+	models.ModeSubtypeProfile8d,
+	models.ModeSubtypeProfile12a,
+	models.ModeSubtypeProfile12b,
+	models.ModeSubtypeProfile17a,
+	models.ModeSubtypeProfile30a,
+	models.ModeSubtypeProfile35b,
+}
+
+func parseStatus(values snmp.Values, lineIndex, channelIndex string) models.Status {
+	var status models.Status
+
+	line := "." + lineIndex
+	channel := "." + channelIndex
+
+	status.State = interpretState(values, oidIfOperStatus+line)
+	status.Mode = interpretMode(values,
+		xdsl2LineEntry+oidXdsl2LineStatusXtuTransSys+line, xdsl2LineEntry+oidXdsl2LineStatusActProfile+line)
+
+	if status.State == models.StateShowtime {
+		status.Uptime = interpretUptime(values, oidSysUpTime, oidIfLastChange+line)
+	}
+
+	status.NearEndInventory = interpretInventory(values,
+		xdsl2LineInventoryEntry+oidXdsl2LInvG994VendorId+line+xdsl2UnitXtur,
+		xdsl2LineInventoryEntry+oidXdsl2LInvVersionNumber+line+xdsl2UnitXtur,
+		adslAturPhys+oidAdslPhysInvVendorID+line,
+		adslAturPhys+oidAdslPhysInvVersionNumber+line)
+	status.FarEndInventory = interpretInventory(values,
+		xdsl2LineInventoryEntry+oidXdsl2LInvG994VendorId+line+xdsl2UnitXtuc,
+		xdsl2LineInventoryEntry+oidXdsl2LInvVersionNumber+line+xdsl2UnitXtuc,
+		adslAtucPhys+oidAdslPhysInvVendorID+line,
+		adslAtucPhys+oidAdslPhysInvVersionNumber+line)
+
+	interpretInventoryDetails(&status.NearEndInventory, values,
+		xdsl2LineInventoryEntry+oidXdsl2LInvSystemVendorId+line+xdsl2UnitXtur,
+		xdsl2LineInventoryEntry+oidXdsl2LInvSerialNumber+line+xdsl2UnitXtur,
+		xdsl2LineInventoryEntry+oidXdsl2LInvSelfTestResult+line+xdsl2UnitXtur,
+		adslAturPhys+oidAdslPhysInvSerialNumber+line)
+	interpretInventoryDetails(&status.FarEndInventory, values,
+		xdsl2LineInventoryEntry+oidXdsl2LInvSystemVendorId+line+xdsl2UnitXtuc,
+		xdsl2LineInventoryEntry+oidXdsl2LInvSerialNumber+line+xdsl2UnitXtuc,
+		xdsl2LineInventoryEntry+oidXdsl2LInvSelfTestResult+line+xdsl2UnitXtuc,
+		adslAtucPhys+oidAdslPhysInvSerialNumber+line)
+
+	// For the channel status, the unit is the transmitting side, as for the channel tables of the
+	// ADSL-LINE-MIB.
+	status.DownstreamActualRate.IntValue = interpretRateValue(values,
+		xdsl2ChStatusEntry+oidXdsl2ChStatusActDataRate+channel+xdsl2UnitXtuc,
+		adslAtucChan+oidAdslChanCurrTxRate+channel)
+	status.UpstreamActualRate.IntValue = interpretRateValue(values,
+		xdsl2ChStatusEntry+oidXdsl2ChStatusActDataRate+channel+xdsl2UnitXtur,
+		adslAturChan+oidAdslChanCurrTxRate+channel)
+
+	status.DownstreamAttainableRate.IntValue = interpretRateValue(values,
+		xdsl2LineEntry+oidXdsl2LineStatusAttainableRateDs+line,
+		adslAturPhys+oidAdslPhysCurrAttainRate+line)
+	status.UpstreamAttainableRate.IntValue = interpretRateValue(values,
+		xdsl2LineEntry+oidXdsl2LineStatusAttainableRateUs+line,
+		adslAtucPhys+oidAdslPhysCurrAttainRate+line)
+
+	status.DownstreamInterleavingDelay.FloatValue = interpretFloatValue(values, 1,
+		xdsl2ChStatusEntry+oidXdsl2ChStatusActDelay+channel+xdsl2UnitXtuc,
+		adslAtucChan+oidAdslChanInterleaveDelay+channel)
+	status.UpstreamInterleavingDelay.FloatValue = interpretFloatValue(values, 1,
+		xdsl2ChStatusEntry+oidXdsl2ChStatusActDelay+channel+xdsl2UnitXtur,
+		adslAturChan+oidAdslChanInterleaveDelay+channel)
+
+	status.DownstreamImpulseNoiseProtection.FloatValue = interpretFloatValue(values, 0.1,
+		xdsl2ChStatusEntry+oidXdsl2ChStatusActInp+channel+xdsl2UnitXtuc)
+	status.UpstreamImpulseNoiseProtection.FloatValue = interpretFloatValue(values, 0.1,
+		xdsl2ChStatusEntry+oidXdsl2ChStatusActInp+channel+xdsl2UnitXtur)
+
+	status.DownstreamSNRMargin.FloatValue = interpretFloatValue(values, 0.1,
+		xdsl2LineBandEntry+oidXdsl2LineBandStatusSnrMargin+line+xdsl2BandDownstream,
+		adslAturPhys+oidAdslPhysCurrSnrMgn+line)
+	status.UpstreamSNRMargin.FloatValue = interpretFloatValue(values, 0.1,
+		xdsl2LineBandEntry+oidXdsl2LineBandStatusSnrMargin+line+xdsl2BandUpstream,
+		adslAtucPhys+oidAdslPhysCurrSnrMgn+line)
+
+	status.DownstreamAttenuation.FloatValue = interpretFloatValue(values, 0.1,
+		xdsl2LineBandEntry+oidXdsl2LineBandStatusLnAtten+line+xdsl2BandDownstream,
+		adslAturPhys+oidAdslPhysCurrAtn+line)
+	status.UpstreamAttenuation.FloatValue = interpretFloatValue(values, 0.1,
+		xdsl2LineBandEntry+oidXdsl2LineBandStatusLnAtten+line+xdsl2BandUpstream,
+		adslAtucPhys+oidAdslPhysCurrAtn+line)
+
+	status.DownstreamPower.FloatValue = interpretFloatValue(values, 0.1,
+		xdsl2LineEntry+oidXdsl2LineStatusActAtpDs+line,
+		adslAtucPhys+oidAdslPhysCurrOutputPwr+line)
+	status.UpstreamPower.FloatValue = interpretFloatValue(values, 0.1,
+		xdsl2LineEntry+oidXdsl2LineStatusActAtpUs+line,
+		adslAturPhys+oidAdslPhysCurrOutputPwr+line)
+
+	// For the performance data, the ATU-C is the receiving side.
+	status.DownstreamFECCount = interpretIntValue(values, adslAturChanPerfData+oidAdslChanCorrectedBlks+channel)
+	status.UpstreamFECCount = interpretIntValue(values, adslAtucChanPerfData+oidAdslChanCorrectedBlks+channel)
+
+	status.DownstreamCRCCount = interpretIntValue(values, adslAturChanPerfData+oidAdslChanUncorrectBlks+channel)
+	status.UpstreamCRCCount = interpretIntValue(values, adslAtucChanPerfData+oidAdslChanUncorrectBlks+channel)
+
+	status.DownstreamESCount = interpretIntValue(values, adslAturPerfData+oidAdslAturPerfESs+line)
+	status.UpstreamESCount = interpretIntValue(values, adslAtucPerfData+oidAdslAtucPerfESs+line)
+
+	return status
+}
+
+func interpretState(values snmp.Values, oid string) (out models.State) {
+	if state, err := values.GetInt64(oid); err == nil {
+		switch state {
+		case ifOperStatusUp:
+			out = models.StateShowtime
+		case ifOperStatusDown, ifOperStatusLowerLayerDown:
+			out = models.StateDown
+		case ifOperStatusDormant:
+			out = models.StateInit
+		}
+	}
+	return
+}
+
+func isBitSet(data []byte, bit int) bool {
+	if bit/8 >= len(data) {
+		return false
+	}
+	return data[bit/8]&(0x80>>(bit%8)) != 0
+}
+
+func interpretMode(values snmp.Values, oidTransSys, oidProfile string) (out models.Mode) {
+	if transSys, err := values.GetBytes(oidTransSys); err == nil {
+		for bit := 0; bit < len(transSys)*8; bit++ {
+			if mode, ok := transmissionModeBits[bit]; ok && isBitSet(transSys, bit) {
+				out.Type = mode.Type
+				out.Subtype = mode.Subtype
+				break
+			}
+		}
+	}
+
+	if out.Type == models.ModeTypeVDSL2 {
+		if profile, err := values.GetBytes(oidProfile); err == nil {
+			for bit, subtype := range profileBits {
+				if isBitSet(profile, bit) {
+					out.Subtype = subtype
+					break
+				}
+			}
+		}
+	}
+
+	return
+}
+
+func interpretUptime(values snmp.Values, oidSysUpTime, oidLastChange string) (out models.Duration) {
+	sysUpTime := values.Get(oidSysUpTime)
+	lastChange := values.Get(oidLastChange)
+	if sysUpTime == nil || lastChange == nil {
+		return
+	}
+
+	sysUpTimeDuration, err1 := sysUpTime.ValDuration()
+	lastChangeDuration, err2 := lastChange.ValDuration()
+	if err1 == nil && err2 == nil && sysUpTimeDuration >= lastChangeDuration {
+		out.Duration = (sysUpTimeDuration - lastChangeDuration).Truncate(time.Second)
+		out.Valid = true
+	}
+
+	return
+}
+
+func isPrintable(str string) bool {
+	for _, r := range str {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func interpretInventory(values snmp.Values, oidG994VendorID, oidVersionNumber,
+	oidLegacyVendorID, oidLegacyVersionNumber string) (out models.Inventory) {
+
+	vendorID, err := values.GetBytes(oidG994VendorID)
+	if err != nil || len(vendorID) != 8 {
+		vendorID, err = values.GetBytes(oidLegacyVendorID)
+	}
+	if err == nil {
+		if len(vendorID) == 8 {
+			helpers.InterpretVendorID(&out, vendorID)
+		} else if isPrintable(string(vendorID)) {
+			out.Vendor = helpers.FormatVendor(string(vendorID))
+		}
+	}
+
+	version, err := values.GetString(oidVersionNumber)
+	if err != nil || version == "" {
+		version, err = values.GetString(oidLegacyVersionNumber)
+	}
+	version = strings.TrimRight(version, "\x00 ")
+	if err == nil && version != "" && isPrintable(version) {
+		out.Version = version
+	}
+
+	return
+}
+
+func interpretInventoryDetails(out *models.Inventory, values snmp.Values, oidSystemVendorID, oidSerialNumber,
+	oidSelfTestResult, oidLegacySerialNumber string) {
+
+	if systemVendorID, err := values.GetBytes(oidSystemVendorID); err == nil {
+		helpers.InterpretSystemVendorID(out, systemVendorID)
+	}
+
+	serialNumber, err := values.GetBytes(oidSerialNumber)
+	if err != nil || len(serialNumber) == 0 {
+		serialNumber, _ = values.GetBytes(oidLegacySerialNumber)
+	}
+	out.SerialNumber = helpers.FormatInventoryString(serialNumber)
+
+	if selfTestResult, err := values.GetUint64(oidSelfTestResult); err == nil {
+		out.SelfTestResult.Int = int64(selfTestResult)
+		out.SelfTestResult.Valid = true
+	}
+}
+
+func getFirstValue(values snmp.Values, oids []string) *snmp.Value {
+	for _, oid := range oids {
+		if val := values.Get(oid); val != nil {
+			return val
+		}
+	}
+	return nil
+}
+
+func interpretRateValue(values snmp.Values, oids ...string) (out models.IntValue) {
+	if val := getFirstValue(values, oids); val != nil {
+		if rate, err := val.ValInt64(); err == nil && rate > 0 {
+			out.Int = rate / 1000
+			out.Valid = true
+		}
+	}
+	return
+}
+
+func interpretFloatValue(values snmp.Values, factor float64, oids ...string) (out models.FloatValue) {
+	if val := getFirstValue(values, oids); val != nil {
+		// special values for "not available" differ between the objects, but are all out of range
+		if valInt, err := val.ValInt64(); err == nil && valInt > -512 && valInt < 1271 {
+			out.Float = float64(valInt) * factor
+			out.Valid = true
+		}
+	}
+	return
+}
+
+func interpretIntValue(values snmp.Values, oid string) (out models.IntValue) {
+	if val, err := values.GetInt64(oid); err == nil {
+		out.Int = val
+		out.Valid = true
+	}
+	return
+}
@@ -0,0 +1,244 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package technicolor
+
+import (
+	"bufio"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"3e8.eu/go/dsl/internal/helpers"
+	"3e8.eu/go/dsl/models"
+)
+
+var regexpLabelParts = regexp.MustCompile(`\(([^)]*)\)|\[[^\]]*\]`)
+var regexpFilterCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+var regexpThousandsSeparator = regexp.MustCompile(`^[0-9]{1,3}([.,][0-9]{3})+$`)
+var regexpUptime = regexp.MustCompile(`([0-9]+) days?,\s*([0-9]+):([0-9]{2}):([0-9]{2})`)
+
+const (
+	directionUp   = "up"
+	directionDown = "down"
+)
+
+// parseDirection maps the column names used by the CLI to the direction of the value. Values of the
+// local end (the modem itself) refer to downstream, values of the remote end to upstream.
+func parseDirection(str string) string {
+	switch strings.ToLower(strings.TrimSpace(str)) {
+	case "up", "us", "remote":
+		return directionUp
+	case "down", "ds", "local":
+		return directionDown
+	}
+	return ""
+}
+
+func parseDirections(list []string) []string {
+	directions := make([]string, len(list))
+	for i, item := range list {
+		directions[i] = parseDirection(item)
+		if directions[i] == "" {
+			return nil
+		}
+	}
+	return directions
+}
+
+// splitLine splits a line at the first colon which is not part of a parenthesized part of the label,
+// as in "Up time (Days hh:mm:ss): 0 days, 1:23:45".
+func splitLine(line string) (label, value string, ok bool) {
+	depth := 0
+	for i, r := range line {
+		switch r {
+		case '(', '[':
+			depth++
+		case ')', ']':
+			depth--
+		case ':':
+			if depth == 0 {
+				return line[:i], strings.TrimSpace(line[i+1:]), true
+			}
+		}
+	}
+	return "", "", false
+}
+
+func parseInfoValues(info string) map[string]string {
+	values := make(map[string]string)
+
+	// used for values in table form without directions in the label
+	columns := []string{directionUp, directionDown}
+
+	scanner := bufio.NewScanner(strings.NewReader(info))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if directions := parseDirections(strings.Fields(line)); len(directions) == 2 {
+			columns = directions
+			continue
+		}
+
+		label, value, ok := splitLine(line)
+		if !ok {
+			continue
+		}
+
+		var directions []string
+		for _, match := range regexpLabelParts.FindAllStringSubmatch(label, -1) {
+			if match[1] != "" {
+				if d := parseDirections(strings.Split(match[1], "/")); d != nil {
+					directions = d
+				}
+			}
+		}
+
+		key := regexpLabelParts.ReplaceAllString(label, "")
+		key = regexpFilterCharacters.ReplaceAllString(strings.ToLower(key), "")
+
+		var items []string
+		if directions != nil {
+			items = strings.Split(value, "/")
+		} else {
+			// table form, using the columns of the last header line
+			directions = columns
+			items = strings.Fields(value)
+		}
+
+		if len(items) == len(directions) {
+			for i, item := range items {
+				values[key+"."+directions[i]] = strings.TrimSpace(item)
+			}
+		}
+
+		values[key] = value
+	}
+
+	return values
+}
+
+func parseExpandedInfo(info string) models.Status {
+	var status models.Status
+
+	values := parseInfoValues(info)
+
+	status.State = interpretState(values["modemstate"])
+	status.Mode = helpers.ParseMode(values["xdsltype"])
+
+	if status.State == models.StateShowtime {
+		status.Uptime = interpretUptime(values["uptime"])
+	}
+
+	status.UpstreamActualRate.IntValue = interpretIntValue(values, directionUp, "bandwidth", "payloadrate", "linerate")
+	status.DownstreamActualRate.IntValue = interpretIntValue(values, directionDown, "bandwidth", "payloadrate", "linerate")
+
+	status.UpstreamAttainableRate.IntValue = interpretIntValue(values, directionUp, "attainablerate", "maximumbandwidth")
+	status.DownstreamAttainableRate.IntValue = interpretIntValue(values, directionDown, "attainablerate", "maximumbandwidth")
+
+	status.UpstreamSNRMargin.FloatValue = interpretFloatValue(values, directionUp, "snmargin", "noisemargin", "margin", "margins")
+	status.DownstreamSNRMargin.FloatValue = interpretFloatValue(values, directionDown, "snmargin", "noisemargin", "margin", "margins")
+
+	status.UpstreamAttenuation.FloatValue = interpretFloatValue(values, directionUp, "lineattenuation", "attenuation")
+	status.DownstreamAttenuation.FloatValue = interpretFloatValue(values, directionDown, "lineattenuation", "attenuation")
+
+	status.UpstreamPower.FloatValue = interpretFloatValue(values, directionUp, "outputpower")
+	status.DownstreamPower.FloatValue = interpretFloatValue(values, directionDown, "outputpower")
+
+	// for the vendor, the local value refers to the modem itself and the remote value to the DSLAM
+	status.NearEndInventory.Vendor = helpers.FormatVendor(values["vendorid."+directionDown])
+	status.FarEndInventory.Vendor = helpers.FormatVendor(values["vendorid."+directionUp])
+
+	status.UpstreamFECCount = interpretIntValue(values, directionUp, "fecerrors")
+	status.DownstreamFECCount = interpretIntValue(values, directionDown, "fecerrors")
+
+	status.UpstreamCRCCount = interpretIntValue(values, directionUp, "crcerrors")
+	status.DownstreamCRCCount = interpretIntValue(values, directionDown, "crcerrors")
+
+	status.UpstreamESCount = interpretIntValue(values, directionUp, "erroredseconds", "errorseconds")
+	status.DownstreamESCount = interpretIntValue(values, directionDown, "erroredseconds", "errorseconds")
+
+	status.UpstreamSESCount = interpretIntValue(values, directionUp, "severelyerroredseconds")
+	status.DownstreamSESCount = interpretIntValue(values, directionDown, "severelyerroredseconds")
+
+	return status
+}
+
+func interpretState(str string) models.State {
+	str = strings.ToLower(str)
+
+	switch {
+
+	case str == "up", str == "showtime":
+		return models.StateShowtime
+
+	case str == "down", str == "idle", str == "disabled":
+		return models.StateDown
+
+	case strings.Contains(str, "handshake"):
+		return models.StateInitHandshake
+
+	case strings.Contains(str, "training"):
+		return models.StateInitTraining
+
+	case strings.Contains(str, "init"), strings.Contains(str, "start"):
+		return models.StateInit
+
+	}
+
+	return models.StateUnknown
+}
+
+func interpretUptime(str string) (out models.Duration) {
+	if matches := regexpUptime.FindStringSubmatch(str); len(matches) > 0 {
+		days, _ := strconv.ParseInt(matches[1], 10, 64)
+		hours, _ := strconv.ParseInt(matches[2], 10, 64)
+		minutes, _ := strconv.ParseInt(matches[3], 10, 64)
+		seconds, _ := strconv.ParseInt(matches[4], 10, 64)
+
+		out.Duration = time.Duration(days)*24*time.Hour +
+			time.Duration(hours)*time.Hour +
+			time.Duration(minutes)*time.Minute +
+			time.Duration(seconds)*time.Second
+
+		out.Valid = true
+	}
+
+	return
+}
+
+func interpretIntValue(values map[string]string, direction string, keys ...string) (out models.IntValue) {
+	for _, key := range keys {
+		if val, ok := values[key+"."+direction]; ok {
+			// rates may be printed with thousands separator (e.g. "16.383")
+			if regexpThousandsSeparator.MatchString(val) {
+				val = strings.NewReplacer(".", "", ",", "").Replace(val)
+			}
+
+			if valInt, err := strconv.ParseInt(val, 10, 64); err == nil {
+				out.Int = valInt
+				out.Valid = true
+				return
+			}
+		}
+	}
+
+	return
+}
+
+func interpretFloatValue(values map[string]string, direction string, keys ...string) (out models.FloatValue) {
+	for _, key := range keys {
+		if val, ok := values[key+"."+direction]; ok {
+			if valFloat, err := strconv.ParseFloat(val, 64); err == nil {
+				out.Float = valFloat
+				out.Valid = true
+				return
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package technicolor
+
+import (
+	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/telnet"
+	"3e8.eu/go/dsl/internal/xdslctl"
+	"3e8.eu/go/dsl/models"
+)
+
+type telnetClient struct {
+	command  string
+	cli      cliType
+	commands xdslctl.Commands
+	client   *telnet.Client
+	rawData  []byte
+	status   models.Status
+	bins     models.Bins
+}
+
+func NewTelnetClient(config TelnetConfig) (dsl.Client, error) {
+	c := telnetClient{}
+	c.command = config.Command
+
+	var err error
+
+	user := config.User
+	if user == "" {
+		user = "Administrator"
+	}
+
+	clientConfig := telnet.ClientConfig{
+		Prompts: []telnet.Prompts{
+			// legacy Technicolor/Thomson CLI, the prompt may be prefixed by the user name (e.g. "{Administrator}=>")
+			telnet.Prompts{
+				Account:  "Username : ",
+				Password: "Password : ",
+				Command:  "=>",
+			},
+			// Homeware firmware (based on OpenWrt)
+			telnet.Prompts{
+				Account:  "login: ",
+				Password: "Password: ",
+				Command:  "# ",
+			},
+		},
+		Serial:      config.Serial,
+		BaudRate:    config.BaudRate,
+		PreCommands: config.PreCommands,
+	}
+	clientConfig.Prompts = telnet.WithCustomPrompts(clientConfig.Prompts,
+		config.AccountPrompt, config.PasswordPrompt, config.CommandPrompt)
+
+	c.client, err = telnet.NewClient(clientConfig, config.Host, user, config.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (c *telnetClient) RawData() []byte {
+	return c.rawData
+}
+
+func (c *telnetClient) Status() models.Status {
+	return c.status
+}
+
+func (c *telnetClient) Bins() models.Bins {
+	return c.bins
+}
+
+func (c *telnetClient) UpdateData() (err error) {
+	c.status, c.bins, c.rawData, err = updateData(c.client, c.command, &c.cli, &c.commands)
+	return
+}
+
+func (c *telnetClient) Close() {
+	c.client.Close()
+}
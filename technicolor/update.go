@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package technicolor
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"3e8.eu/go/dsl/internal/exec"
+	"3e8.eu/go/dsl/internal/xdslctl"
+	"3e8.eu/go/dsl/models"
+)
+
+var regexpUnknownCommand = regexp.MustCompile(`(?i)unknown command|invalid command|syntax error`)
+
+type cliType int
+
+const (
+	cliTypeUnknown cliType = iota
+	cliTypeXdslctl
+	cliTypeLegacy
+)
+
+// detectCLI checks whether the Broadcom xdslctl command is available (as on devices with Homeware
+// firmware), or if only the legacy Technicolor/Thomson CLI can be used.
+func detectCLI(e exec.Executor, command string) (cliType, error) {
+	if command == "" {
+		command = "xdslctl"
+	}
+
+	output, err := e.Execute(command + " --version")
+	if exec.IsCommandNotFound(output, err) || regexpUnknownCommand.MatchString(output) {
+		return cliTypeLegacy, nil
+	} else if err != nil {
+		return cliTypeUnknown, err
+	}
+
+	return cliTypeXdslctl, nil
+}
+
+func updateData(e exec.Executor, command string, cli *cliType, commands *xdslctl.Commands) (status models.Status, bins models.Bins, rawData []byte, err error) {
+	if *cli == cliTypeUnknown {
+		*cli, err = detectCLI(e, command)
+		if err != nil {
+			return
+		}
+	}
+
+	if *cli == cliTypeXdslctl {
+		return xdslctl.UpdateData(e, command, commands)
+	}
+
+	info, err := e.Execute("xdsl info expanded")
+	if err != nil {
+		return
+	}
+	if regexpUnknownCommand.MatchString(info) || !strings.Contains(strings.ToLower(info), "modem state") {
+		err = errors.New("neither xdslctl nor xdsl info is available, check the configuration")
+		return
+	}
+
+	status = parseExpandedInfo(info)
+	bins.Mode = status.Mode
+
+	var b strings.Builder
+	fmt.Fprintln(&b, "# xdsl info expanded")
+	fmt.Fprintln(&b, info)
+	fmt.Fprintln(&b)
+	rawData = []byte(b.String())
+
+	return
+}
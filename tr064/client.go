@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package tr064
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/tr064"
+	"3e8.eu/go/dsl/models"
+)
+
+type client struct {
+	session *session
+	rawData []byte
+	status  models.Status
+	bins    models.Bins
+}
+
+func NewClient(config Config) (dsl.Client, error) {
+	c := client{}
+
+	var err error
+
+	c.session, err = newSession(config.Host, config.User, config.Password, config.TLSSkipVerify)
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (c *client) RawData() []byte {
+	return c.rawData
+}
+
+func (c *client) Status() models.Status {
+	return c.status
+}
+
+func (c *client) Bins() models.Bins {
+	return c.bins
+}
+
+func writeRawData(b *strings.Builder, action string, data string) {
+	fmt.Fprintf(b, "////// %s#%s\n\n", serviceWANDSLInterfaceConfig, action)
+	b.WriteString(data)
+	b.WriteString("\n\n")
+}
+
+func (c *client) UpdateData() (err error) {
+	var b strings.Builder
+
+	infoData, err := c.session.call(serviceWANDSLInterfaceConfig, "GetInfo")
+	if err != nil {
+		return
+	}
+	writeRawData(&b, "GetInfo", infoData)
+
+	info, err := tr064.ParseInterfaceConfigInfo(infoData)
+	if err != nil {
+		return
+	}
+
+	// the statistics are optional in the specification
+	statisticsData, err := c.session.call(serviceWANDSLInterfaceConfig, "GetStatisticsTotal")
+	if err != nil && !errors.Is(err, errActionNotSupported) {
+		return
+	}
+	err = nil
+
+	var statistics *tr064.InterfaceConfigStatisticsTotal
+	if statisticsData != "" {
+		writeRawData(&b, "GetStatisticsTotal", statisticsData)
+
+		statistics, err = tr064.ParseInterfaceConfigStatisticsTotal(statisticsData)
+		if err != nil {
+			return
+		}
+	}
+
+	c.status = interpretStatus(info, statistics)
+	c.bins = models.Bins{Mode: c.status.Mode}
+	c.rawData = []byte(b.String())
+
+	return
+}
+
+func (c *client) Close() {
+	c.session.close()
+}
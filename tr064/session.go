@@ -0,0 +1,258 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package tr064
+
+import (
+	"crypto/tls"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/httpdigest"
+)
+
+const (
+	defaultPortHTTP  = "49000"
+	defaultPortHTTPS = "49443"
+)
+
+const serviceWANDSLInterfaceConfig = "WANDSLInterfaceConfig"
+
+// UPnP error code for unknown actions
+const upnpErrorInvalidAction = 401
+
+var errActionNotSupported = errors.New("action not supported")
+
+var regexpPort = regexp.MustCompile(`:[0-9]+$`)
+
+type session struct {
+	host     string
+	username string
+	password string
+	client   *http.Client
+	services map[string]service
+}
+
+type deviceDescription struct {
+	XMLName xml.Name `xml:"root"`
+	Device  device   `xml:"device"`
+}
+
+type device struct {
+	Services []service `xml:"serviceList>service"`
+	Devices  []device  `xml:"deviceList>device"`
+}
+
+type service struct {
+	ServiceType string `xml:"serviceType"`
+	ControlURL  string `xml:"controlURL"`
+}
+
+type soapFault struct {
+	XMLName   xml.Name `xml:"Envelope"`
+	ErrorCode int      `xml:"Body>Fault>detail>UPnPError>errorCode"`
+	ErrorDesc string   `xml:"Body>Fault>detail>UPnPError>errorDescription"`
+}
+
+func newSession(host, username string, passwordCallback dsl.PasswordCallback, tlsSkipVerify bool) (*session, error) {
+	s := session{}
+	s.username = username
+
+	if !strings.HasPrefix(host, "http://") && !strings.HasPrefix(host, "https://") {
+		host = "http://" + host
+	}
+	if host[len(host)-1] == '/' {
+		host = host[:len(host)-1]
+	}
+	if strings.Count(host, "/") != 2 {
+		return nil, errors.New("invalid host")
+	}
+	if !regexpPort.MatchString(host) {
+		if strings.HasPrefix(host, "https://") {
+			host += ":" + defaultPortHTTPS
+		} else {
+			host += ":" + defaultPortHTTP
+		}
+	}
+	s.host = host
+
+	s.client = &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: tlsSkipVerify},
+		},
+	}
+
+	err := s.loadServices()
+	if err != nil {
+		return nil, err
+	}
+
+	if passwordCallback != nil {
+		s.password, err = passwordCallback()
+		if err != nil {
+			return nil, &dsl.AuthenticationError{Err: err}
+		}
+	}
+
+	// make sure that the credentials are valid and the DSL interface is available
+	_, err = s.call(serviceWANDSLInterfaceConfig, "GetInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (s *session) loadServices() error {
+	resp, err := s.client.Get(s.host + "/tr64desc.xml")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("loading device description failed with status %d - make sure that TR-064 is enabled", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var desc deviceDescription
+	err = xml.Unmarshal(body, &desc)
+	if err != nil {
+		return fmt.Errorf("invalid device description: %w", err)
+	}
+
+	s.services = make(map[string]service)
+	addServices(s.services, &desc.Device)
+
+	if _, ok := s.services[serviceWANDSLInterfaceConfig]; !ok {
+		return errors.New("device has no DSL interface (WANDSLInterfaceConfig service not found)")
+	}
+
+	return nil
+}
+
+func addServices(services map[string]service, d *device) {
+	for _, service := range d.Services {
+		// service types have the form urn:dslforum-org:service:<name>:<version>
+		parts := strings.Split(service.ServiceType, ":")
+		if len(parts) != 5 {
+			continue
+		}
+
+		// the first occurrence is used if there are multiple devices with the same service
+		if _, ok := services[parts[3]]; !ok {
+			services[parts[3]] = service
+		}
+	}
+
+	for i := range d.Devices {
+		addServices(services, &d.Devices[i])
+	}
+}
+
+func (s *session) newRequest(url, serviceType, action string) (*http.Request, error) {
+	soapRequest := fmt.Sprintf(
+		`<?xml version="1.0"?>`+
+			`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/" s:encodingStyle="http://schemas.xmlsoap.org/soap/encoding/">`+
+			`<s:Body><u:%[2]s xmlns:u="%[1]s"></u:%[2]s></s:Body>`+
+			`</s:Envelope>`,
+		serviceType, action)
+
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(soapRequest))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "text/xml; charset=utf-8")
+	req.Header.Set("SOAPAction", serviceType+"#"+action)
+
+	return req, nil
+}
+
+func (s *session) call(serviceName, action string) (string, error) {
+	service, ok := s.services[serviceName]
+	if !ok {
+		return "", errActionNotSupported
+	}
+
+	url := s.host + service.ControlURL
+
+	req, err := s.newRequest(url, service.ServiceType, action)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 401 {
+		user := s.username
+		if user == "" {
+			// authentication seems to fail on some devices when username is empty string
+			user = " "
+		}
+
+		authorization, err := httpdigest.GetAuthorization(resp, user, s.password)
+		if err != nil {
+			return "", err
+		}
+
+		req, err = s.newRequest(url, service.ServiceType, action)
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("Authorization", authorization)
+
+		resp, err = s.client.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode == 401 {
+			return "", &dsl.AuthenticationError{Err: errors.New("authentication failed")}
+		}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode == 500 {
+		var fault soapFault
+		if xml.Unmarshal(body, &fault) == nil && fault.ErrorCode != 0 {
+			if fault.ErrorCode == upnpErrorInvalidAction {
+				return "", errActionNotSupported
+			}
+			return "", fmt.Errorf("action %s#%s failed: %s (%d)", serviceName, action, fault.ErrorDesc, fault.ErrorCode)
+		}
+	}
+
+	if resp.StatusCode != 200 {
+		return "", fmt.Errorf("action %s#%s failed with status %d", serviceName, action, resp.StatusCode)
+	}
+
+	return string(body), nil
+}
+
+// close releases the idle connections of the HTTP client, there is no session to log out from
+func (s *session) close() {
+	s.client.CloseIdleConnections()
+}
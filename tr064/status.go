@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package tr064
+
+import (
+	"strings"
+	"time"
+
+	"3e8.eu/go/dsl/internal/helpers"
+	"3e8.eu/go/dsl/internal/tr064"
+	"3e8.eu/go/dsl/models"
+)
+
+func interpretStatus(info *tr064.InterfaceConfigInfo, statistics *tr064.InterfaceConfigStatisticsTotal) models.Status {
+	var status models.Status
+
+	status.State = helpers.ParseStateTR06X(info.Status)
+
+	if info.StandardUsed != "" {
+		status.Mode = helpers.ParseMode(info.StandardUsed)
+	} else {
+		status.Mode = helpers.ParseMode(info.ModulationType)
+	}
+
+	interpretStatusUptime(&status, info, statistics)
+	interpretStatusInventory(&status, info)
+
+	status.UpstreamActualRate.IntValue = tr064.ParseIntValue(info.UpstreamCurrRate)
+	status.DownstreamActualRate.IntValue = tr064.ParseIntValue(info.DownstreamCurrRate)
+
+	status.UpstreamAttainableRate.IntValue = tr064.ParseIntValue(info.UpstreamMaxRate)
+	status.DownstreamAttainableRate.IntValue = tr064.ParseIntValue(info.DownstreamMaxRate)
+
+	status.UpstreamSNRMargin.FloatValue = convertFloatValue(tr064.ParseIntValue(info.UpstreamNoiseMargin), 0.1)
+	status.DownstreamSNRMargin.FloatValue = convertFloatValue(tr064.ParseIntValue(info.DownstreamNoiseMargin), 0.1)
+
+	status.UpstreamAttenuation.FloatValue = convertFloatValue(tr064.ParseIntValue(info.UpstreamAttenuation), 0.1)
+	status.DownstreamAttenuation.FloatValue = convertFloatValue(tr064.ParseIntValue(info.DownstreamAttenuation), 0.1)
+
+	status.UpstreamPower.FloatValue = tr064.InterpretPower(info.UpstreamPower)
+	status.DownstreamPower.FloatValue = tr064.InterpretPower(info.DownstreamPower)
+
+	if statistics != nil {
+		tr064.InterpretStatistics(&status, statistics)
+	}
+
+	return status
+}
+
+func interpretStatusUptime(status *models.Status, info *tr064.InterfaceConfigInfo, statistics *tr064.InterfaceConfigStatisticsTotal) {
+	if status.State != models.StateShowtime {
+		return
+	}
+
+	// not part of all versions of the specification, so it is only available on some devices
+	showtimeStart := tr064.ParseIntValue(info.ShowtimeStart)
+	if !showtimeStart.Valid && statistics != nil {
+		showtimeStart = tr064.ParseIntValue(statistics.ShowtimeStart)
+	}
+
+	if showtimeStart.Valid {
+		status.Uptime.Valid = true
+		status.Uptime.Duration = time.Duration(showtimeStart.Int) * time.Second
+	}
+}
+
+func interpretVendor(vendor string) string {
+	vendor = strings.TrimSpace(vendor)
+
+	// the vendor ID may be encoded as hexadecimal string
+	if len(vendor) == 8 {
+		if decoded := helpers.ParseHexadecimal(vendor); len(decoded) == 4 {
+			vendor = string(decoded)
+		}
+	}
+
+	return helpers.FormatVendor(vendor)
+}
+
+func interpretStatusInventory(status *models.Status, info *tr064.InterfaceConfigInfo) {
+	status.FarEndInventory.Vendor = interpretVendor(info.ATUCVendor)
+	status.NearEndInventory.Vendor = interpretVendor(info.ATURVendor)
+}
+
+func convertFloatValue(val models.IntValue, factor float64) (out models.FloatValue) {
+	out.Float = float64(val.Int) * factor
+	out.Valid = val.Valid
+	return
+}
@@ -0,0 +1,52 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package tr181
+
+import (
+	"3e8.eu/go/dsl/internal/tr181"
+	"3e8.eu/go/dsl/models"
+)
+
+// transport loads the Device.DSL object from a device. Adding support for a new way to access the
+// TR-181 data model only requires a new implementation of this interface.
+type transport interface {
+	loadData() (rawData []byte, data *tr181.DSL, err error)
+	close()
+}
+
+type client struct {
+	transport transport
+	rawData   []byte
+	status    models.Status
+	bins      models.Bins
+}
+
+func (c *client) RawData() []byte {
+	return c.rawData
+}
+
+func (c *client) Status() models.Status {
+	return c.status
+}
+
+func (c *client) Bins() models.Bins {
+	return c.bins
+}
+
+func (c *client) UpdateData() (err error) {
+	rawData, data, err := c.transport.loadData()
+	if err != nil {
+		return
+	}
+
+	c.rawData = rawData
+	c.status, c.bins, err = tr181.Interpret(data)
+
+	return
+}
+
+func (c *client) Close() {
+	c.transport.close()
+}
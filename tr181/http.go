@@ -0,0 +1,171 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package tr181
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/tr181"
+	"3e8.eu/go/dsl/internal/xmo"
+)
+
+func NewHTTPClient(config HTTPConfig) (dsl.Client, error) {
+	c := client{}
+
+	var err error
+
+	switch config.Transport {
+
+	case TransportJSON, "":
+		c.transport, err = newJSONTransport(config)
+
+	case TransportXMO:
+		c.transport, err = newXMOTransport(config)
+
+	default:
+		err = errors.New("invalid transport")
+
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+type jsonTransport struct {
+	url      string
+	username string
+	password string
+	client   *http.Client
+}
+
+func newJSONTransport(config HTTPConfig) (*jsonTransport, error) {
+	t := jsonTransport{}
+	t.username = config.User
+
+	host := config.Host
+	if !strings.HasPrefix(host, "http://") && !strings.HasPrefix(host, "https://") {
+		host = "http://" + host
+	}
+	if host[len(host)-1] == '/' {
+		host = host[:len(host)-1]
+	}
+	if strings.Count(host, "/") != 2 {
+		return nil, errors.New("invalid host")
+	}
+
+	if config.Path == "" {
+		return nil, errors.New("path of JSON data is required")
+	}
+	if config.Path[0] != '/' {
+		t.url = host + "/" + config.Path
+	} else {
+		t.url = host + config.Path
+	}
+
+	t.client = &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: config.TLSSkipVerify},
+		},
+	}
+
+	if config.User != "" && config.Password != nil {
+		var err error
+		t.password, err = config.Password()
+		if err != nil {
+			return nil, &dsl.AuthenticationError{Err: err}
+		}
+	}
+
+	return &t, nil
+}
+
+func (t *jsonTransport) loadData() ([]byte, *tr181.DSL, error) {
+	req, err := http.NewRequest(http.MethodGet, t.url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if t.username != "" {
+		req.SetBasicAuth(t.username, t.password)
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 401 {
+		return nil, nil, &dsl.AuthenticationError{Err: errors.New("authentication failed")}
+	}
+	if resp.StatusCode != 200 {
+		return nil, nil, fmt.Errorf("request for JSON data failed with status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := tr181.ParseJSON(body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body, data, nil
+}
+
+func (t *jsonTransport) close() {
+}
+
+type xmoTransport struct {
+	session *xmo.Session
+}
+
+func newXMOTransport(config HTTPConfig) (*xmoTransport, error) {
+	t := xmoTransport{}
+
+	user := config.User
+	if user == "" {
+		user = "admin"
+	}
+
+	var err error
+	t.session, err = xmo.NewSession(config.Host, user, config.Password, config.TLSSkipVerify)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (t *xmoTransport) loadData() ([]byte, *tr181.DSL, error) {
+	rawData, err := t.session.LoadValue("Device/DSL")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := tr181.ParseJSON(rawData)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rawData, data, nil
+}
+
+func (t *xmoTransport) close() {
+	t.session.Close()
+}
@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package tr181
+
+import (
+	"3e8.eu/go/dsl"
+)
+
+func init() {
+	newHTTP := func(config dsl.Config) (dsl.Client, error) {
+		httpConfig := HTTPConfig{
+			Host:          config.Host,
+			User:          config.User,
+			Password:      config.AuthPassword,
+			TLSSkipVerify: config.Options["TLSSkipVerify"] == "1",
+			Transport:     Transport(config.Options["Transport"]),
+			Path:          config.Options["Path"],
+		}
+		return NewHTTPClient(httpConfig)
+	}
+	clientDescHTTP := dsl.ClientDesc{
+		Title:              "TR-181 (HTTP)",
+		RequiresUser:       dsl.TristateMaybe,
+		SupportedAuthTypes: dsl.AuthTypePassword,
+		Options: map[string]dsl.Option{
+			"Transport": dsl.Option{
+				Description: "protocol used to load the data model",
+				Type:        dsl.OptionTypeEnum,
+				Values: []dsl.OptionValue{
+					dsl.OptionValue{Value: string(TransportJSON), Title: "JSON dump"},
+					dsl.OptionValue{Value: string(TransportXMO), Title: "XMO API (Sagemcom)"},
+				},
+			},
+			"Path": dsl.Option{
+				Description: "path of the JSON dump on the device",
+				Type:        dsl.OptionTypeString,
+			},
+			"TLSSkipVerify": dsl.Option{
+				Description: "skip verification of TLS certificates",
+				Type:        dsl.OptionTypeBool,
+			},
+		},
+	}
+	dsl.RegisterClient("tr181_http", newHTTP, clientDescHTTP)
+
+	options := map[string]dsl.Option{
+		"Command": dsl.Option{
+			Description: "name of the obuspa command on the device",
+			Type:        dsl.OptionTypeString,
+		},
+	}
+
+	newSSH := func(config dsl.Config) (dsl.Client, error) {
+		sshConfig := SSHConfig{
+			Host:           config.Host,
+			User:           config.User,
+			Password:       config.AuthPassword,
+			PrivateKeys:    config.AuthPrivateKeys,
+			SSHAgent:       config.AuthSSHAgent,
+			KnownHosts:     config.KnownHosts,
+			UnknownHostKey: config.UnknownHostKey,
+			JumpHost:       config.JumpHost,
+			Command:        config.Options["Command"],
+		}
+		return NewSSHClient(sshConfig)
+	}
+	clientDescSSH := dsl.ClientDesc{
+		Title:              "TR-181 (USP, SSH)",
+		RequiresUser:       dsl.TristateYes,
+		SupportedAuthTypes: dsl.AuthTypePassword | dsl.AuthTypePrivateKeys | dsl.AuthTypeSSHAgent,
+		RequiresKnownHosts: true,
+		SupportsJumpHost:   true,
+		Options:            options,
+	}
+	dsl.RegisterClient("tr181_usp_ssh", newSSH, clientDescSSH)
+
+	newLocal := func(config dsl.Config) (dsl.Client, error) {
+		localConfig := LocalConfig{
+			Command: config.Options["Command"],
+		}
+		return NewLocalClient(localConfig)
+	}
+	clientDescLocal := dsl.ClientDesc{
+		Title:        "TR-181 (USP, Local)",
+		RequiresUser: dsl.TristateNo,
+		IsLocal:      true,
+		Options:      options,
+	}
+	dsl.RegisterClient("tr181_usp_local", newLocal, clientDescLocal)
+}
@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package tr181
+
+import (
+	"errors"
+	"strings"
+
+	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/exec"
+	"3e8.eu/go/dsl/internal/ssh"
+	"3e8.eu/go/dsl/internal/tr181"
+)
+
+// uspTransport reads the data model using the command line interface of the OB-USP-Agent, which is
+// available on devices with USP support based on prplOS or RDK-B.
+type uspTransport struct {
+	executor  exec.Executor
+	command   string
+	sshClient *ssh.Client
+}
+
+func NewSSHClient(config SSHConfig) (dsl.Client, error) {
+	t := uspTransport{}
+	t.command = config.Command
+
+	var err error
+
+	t.sshClient, err = ssh.NewClient(config.Host, config.User, config.Password, config.PrivateKeys, config.SSHAgent, config.KnownHosts, config.UnknownHostKey, config.JumpHost)
+	if err != nil {
+		return nil, err
+	}
+	t.executor = t.sshClient
+
+	return &client{transport: &t}, nil
+}
+
+func NewLocalClient(config LocalConfig) (dsl.Client, error) {
+	t := uspTransport{}
+	t.command = config.Command
+	t.executor = &exec.LocalExecutor{}
+
+	return &client{transport: &t}, nil
+}
+
+func (t *uspTransport) loadData() ([]byte, *tr181.DSL, error) {
+	command := t.command
+	if command == "" {
+		command = "obuspa"
+	}
+
+	output, err := t.executor.Execute(command + " -c get Device.DSL.")
+	if exec.IsCommandNotFound(output, err) {
+		return nil, nil, errors.New("command not found, check the configuration")
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	params := make(map[string]string)
+
+	for _, line := range strings.Split(output, "\n") {
+		// each parameter is printed as "<path> => <value>"
+		parts := strings.SplitN(line, " => ", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		params[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+
+	data, err := tr181.ParseParameters(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return []byte(output), data, nil
+}
+
+func (t *uspTransport) close() {
+	if t.sshClient != nil {
+		t.sshClient.Close()
+	}
+}
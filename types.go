@@ -17,6 +17,7 @@ type AuthTypes int
 const (
 	AuthTypePassword AuthTypes = 1 << iota
 	AuthTypePrivateKeys
+	AuthTypeSSHAgent
 )
 
 type OptionType int
@@ -43,7 +44,9 @@ type ClientDesc struct {
 	RequiresUser                 Tristate
 	SupportedAuthTypes           AuthTypes
 	RequiresKnownHosts           bool
+	SupportsJumpHost             bool
 	SupportsEncryptionPassphrase bool
+	IsLocal                      bool
 	Options                      map[string]Option
 }
 
@@ -0,0 +1,41 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zyxel
+
+import (
+	"3e8.eu/go/dsl"
+)
+
+type TelnetConfig struct {
+	Host           string
+	User           string
+	Password       dsl.PasswordCallback
+	Command        string
+	Serial         bool
+	BaudRate       int
+	AccountPrompt  string
+	PasswordPrompt string
+	CommandPrompt  string
+	PreCommands    []string
+}
+
+type SSHConfig struct {
+	Host           string
+	User           string
+	Password       dsl.PasswordCallback
+	PrivateKeys    dsl.PrivateKeysCallback
+	SSHAgent       bool
+	KnownHosts     string
+	UnknownHostKey dsl.UnknownHostKeyCallback
+	JumpHost       *dsl.JumpHostConfig
+	Command        string
+}
+
+type HTTPConfig struct {
+	Host          string
+	User          string
+	Password      dsl.PasswordCallback
+	TLSSkipVerify bool
+}
@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zyxel
+
+import (
+	"3e8.eu/go/dsl"
+	"3e8.eu/go/dsl/internal/telnet"
+)
+
+func init() {
+	options := map[string]dsl.Option{
+		"Command": dsl.Option{
+			Description: "name of the xdslctl command on the device",
+			Type:        dsl.OptionTypeString,
+		},
+	}
+
+	telnetOptions := map[string]dsl.Option{
+		"Command":        options["Command"],
+		"Serial":         telnet.GetSerialOption(),
+		"BaudRate":       telnet.GetBaudRateOption(),
+		"AccountPrompt":  telnet.GetAccountPromptOption(),
+		"PasswordPrompt": telnet.GetPasswordPromptOption(),
+		"CommandPrompt":  telnet.GetCommandPromptOption(),
+		"PreCommands":    telnet.GetPreCommandsOption(),
+	}
+
+	newTelnet := func(config dsl.Config) (dsl.Client, error) {
+		telnetConfig := TelnetConfig{
+			Host:           config.Host,
+			User:           config.User,
+			Password:       config.AuthPassword,
+			Command:        config.Options["Command"],
+			Serial:         config.Options["Serial"] == "1",
+			BaudRate:       telnet.ParseBaudRate(config.Options["BaudRate"]),
+			AccountPrompt:  config.Options["AccountPrompt"],
+			PasswordPrompt: config.Options["PasswordPrompt"],
+			CommandPrompt:  config.Options["CommandPrompt"],
+			PreCommands:    telnet.ParsePreCommands(config.Options["PreCommands"]),
+		}
+		return NewTelnetClient(telnetConfig)
+	}
+	clientDescTelnet := dsl.ClientDesc{
+		Title:              "Zyxel (Telnet)",
+		RequiresUser:       dsl.TristateMaybe,
+		SupportedAuthTypes: dsl.AuthTypePassword,
+		Options:            telnetOptions,
+	}
+	dsl.RegisterClient("zyxel_telnet", newTelnet, clientDescTelnet)
+
+	newSSH := func(config dsl.Config) (dsl.Client, error) {
+		sshConfig := SSHConfig{
+			Host:           config.Host,
+			User:           config.User,
+			Password:       config.AuthPassword,
+			PrivateKeys:    config.AuthPrivateKeys,
+			SSHAgent:       config.AuthSSHAgent,
+			KnownHosts:     config.KnownHosts,
+			UnknownHostKey: config.UnknownHostKey,
+			JumpHost:       config.JumpHost,
+			Command:        config.Options["Command"],
+		}
+		return NewSSHClient(sshConfig)
+	}
+	clientDescSSH := dsl.ClientDesc{
+		Title:              "Zyxel (SSH)",
+		RequiresUser:       dsl.TristateYes,
+		SupportedAuthTypes: dsl.AuthTypePassword | dsl.AuthTypePrivateKeys | dsl.AuthTypeSSHAgent,
+		RequiresKnownHosts: true,
+		SupportsJumpHost:   true,
+		Options:            options,
+	}
+	dsl.RegisterClient("zyxel_ssh", newSSH, clientDescSSH)
+
+	newHTTP := func(config dsl.Config) (dsl.Client, error) {
+		httpConfig := HTTPConfig{
+			Host:          config.Host,
+			User:          config.User,
+			Password:      config.AuthPassword,
+			TLSSkipVerify: config.Options["TLSSkipVerify"] == "1",
+		}
+		return NewHTTPClient(httpConfig)
+	}
+	clientDescHTTP := dsl.ClientDesc{
+		Title:              "Zyxel (Web interface)",
+		RequiresUser:       dsl.TristateMaybe,
+		SupportedAuthTypes: dsl.AuthTypePassword,
+		Options: map[string]dsl.Option{
+			"TLSSkipVerify": dsl.Option{
+				Description: "skip verification of TLS certificates",
+				Type:        dsl.OptionTypeBool,
+			},
+		},
+	}
+	dsl.RegisterClient("zyxel_http", newHTTP, clientDescHTTP)
+}
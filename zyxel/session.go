@@ -0,0 +1,177 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zyxel
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"strings"
+	"time"
+
+	"3e8.eu/go/dsl"
+)
+
+const resultSuccess = "ZCFG_SUCCESS"
+
+type session struct {
+	host       string
+	client     *http.Client
+	sessionKey string
+}
+
+type loginRequest struct {
+	Account          string `json:"Input_Account"`
+	Password         string `json:"Input_Passwd"`
+	Language         string `json:"currLang"`
+	RememberPassword int    `json:"RememberPassword"`
+	SHA512Password   bool   `json:"SHA512_password"`
+}
+
+type loginResponse struct {
+	Result     string      `json:"result"`
+	SessionKey json.Number `json:"sessionkey"`
+}
+
+func newSession(host, username string, passwordCallback dsl.PasswordCallback, tlsSkipVerify bool) (*session, error) {
+	s := session{}
+
+	if !strings.HasPrefix(host, "http://") && !strings.HasPrefix(host, "https://") {
+		host = "http://" + host
+	}
+	if host[len(host)-1] == '/' {
+		host = host[:len(host)-1]
+	}
+	if strings.Count(host, "/") != 2 {
+		return nil, errors.New("invalid host")
+	}
+	s.host = host
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.client = &http.Client{
+		Timeout: 10 * time.Second,
+		Jar:     jar,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: tlsSkipVerify},
+		},
+	}
+
+	password, err := passwordCallback()
+	if err != nil {
+		return nil, &dsl.AuthenticationError{Err: err}
+	}
+
+	err = s.login(username, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (s *session) login(username, password string) error {
+	// only firmware versions transmitting the password in plain base64 encoding are supported, newer
+	// versions use an encrypted login request instead
+	request := loginRequest{
+		Account:  username,
+		Password: base64.StdEncoding.EncodeToString([]byte(password)),
+		Language: "en",
+	}
+
+	requestJSON, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	body, statusCode, err := s.request("POST", "/UserLogin", requestJSON)
+	if err != nil {
+		return err
+	}
+
+	var response loginResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		if statusCode == 404 {
+			return errors.New("login page not found, the device may not be supported")
+		}
+		return fmt.Errorf("unexpected login response: %w", err)
+	}
+
+	if response.Result != resultSuccess {
+		err = fmt.Errorf("login failed: %s", response.Result)
+		return &dsl.AuthenticationError{Err: err}
+	}
+
+	s.sessionKey = response.SessionKey.String()
+
+	return nil
+}
+
+func (s *session) request(method, path string, data []byte) (body []byte, statusCode int, err error) {
+	var reader io.Reader
+	if data != nil {
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, s.host+path, reader)
+	if err != nil {
+		return
+	}
+
+	if data != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if s.sessionKey != "" {
+		req.Header.Set("CSRFToken", s.sessionKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	statusCode = resp.StatusCode
+
+	body, err = io.ReadAll(resp.Body)
+	return
+}
+
+func (s *session) get(path string) ([]byte, error) {
+	body, statusCode, err := s.request("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode == 401 || statusCode == 403 {
+		err = fmt.Errorf("request for %s failed with status %d", path, statusCode)
+		return nil, &dsl.ConnectionError{Err: err}
+	}
+	if statusCode != 200 {
+		return nil, fmt.Errorf("request for %s failed with status %d", path, statusCode)
+	}
+
+	return body, nil
+}
+
+func (s *session) close() {
+	if s.sessionKey == "" {
+		return
+	}
+
+	s.request("POST", "/cgi-bin/UserLogout", nil)
+
+	s.sessionKey = ""
+}
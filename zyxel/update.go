@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package zyxel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"3e8.eu/go/dsl/internal/xdslctl"
+	"3e8.eu/go/dsl/models"
+)
+
+func updateData(s *session) (status models.Status, bins models.Bins, rawData []byte, err error) {
+	// the xDSL statistics page of the web interface contains the output of "xdslctl info --stats"
+	data, err := s.get("/cgi-bin/xDSLStatistics_handle?line=0")
+	if err != nil {
+		return
+	}
+
+	stats, err := extractStats(data)
+	if err != nil {
+		return
+	}
+
+	status = xdslctl.ParseStatus(stats, "", "", "")
+	bins.Mode = status.Mode
+
+	var b strings.Builder
+	fmt.Fprintln(&b, "# /cgi-bin/xDSLStatistics_handle?line=0")
+	fmt.Fprintln(&b, string(data))
+	fmt.Fprintln(&b)
+	rawData = []byte(b.String())
+
+	return
+}
+
+func extractStats(data []byte) (string, error) {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		// some firmware versions return plain text
+		if strings.Contains(string(data), xdslctl.StatsMarker) {
+			return string(data), nil
+		}
+		return "", errors.New("unexpected response, neither JSON nor xDSL statistics")
+	}
+
+	if stats := findStatsString(value); stats != "" {
+		return stats, nil
+	}
+
+	return "", errors.New("no xDSL statistics found in response")
+}
+
+func findStatsString(value interface{}) string {
+	switch v := value.(type) {
+
+	case string:
+		if strings.Contains(v, xdslctl.StatsMarker) {
+			return v
+		}
+
+	case []interface{}:
+		for _, item := range v {
+			if str := findStatsString(item); str != "" {
+				return str
+			}
+		}
+
+	case map[string]interface{}:
+		for _, item := range v {
+			if str := findStatsString(item); str != "" {
+				return str
+			}
+		}
+
+	}
+
+	return ""
+}